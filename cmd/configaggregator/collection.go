@@ -0,0 +1,113 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM/configuration-aggregator-go-sdk/configurationaggregatorv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+const statusUsage = `Usage: configaggregator status [flags]
+
+Gets the status of the resource collection.
+
+Flags:
+  --output string     The output format: json or yaml (default "json")
+`
+
+const reconcileUsage = `Usage: configaggregator reconcile [flags]
+
+Triggers a resource collection.
+
+Flags:
+  --wait              Wait for the collection to complete, and print its status
+  --interval duration The initial interval between status checks (default 5s)
+  --timeout duration  The maximum time to wait (default 30m)
+  --output string     The output format: json or yaml (default "json")
+`
+
+func (c *cli) status(ctx context.Context, args []string) error {
+	flags := c.newFlagSet("status", statusUsage)
+	output := flags.String("output", outputJSON, "")
+	if err := c.parseFlags(flags, statusUsage, args); err != nil {
+		return err
+	}
+	if err := checkOutput(*output); err != nil {
+		return err
+	}
+
+	service, err := c.newService()
+	if err != nil {
+		return err
+	}
+	status, _, err := service.GetResourceCollectionStatusWithContext(ctx, service.NewGetResourceCollectionStatusOptions())
+	if err != nil {
+		return err
+	}
+	return c.printValue(*output, status)
+}
+
+// reconcileOutput is the output of "reconcile --wait".
+type reconcileOutput struct {
+	Message *string                                   `json:"message,omitempty"`
+	Status  *configurationaggregatorv1.StatusResponse `json:"status"`
+}
+
+func (c *cli) reconcile(ctx context.Context, args []string) error {
+	flags := c.newFlagSet("reconcile", reconcileUsage)
+	wait := flags.Bool("wait", false, "")
+	interval := flags.Duration("interval", 0, "")
+	timeout := flags.Duration("timeout", 0, "")
+	output := flags.String("output", outputJSON, "")
+	if err := c.parseFlags(flags, reconcileUsage, args); err != nil {
+		return err
+	}
+	if err := checkOutput(*output); err != nil {
+		return err
+	}
+
+	service, err := c.newService()
+	if err != nil {
+		return err
+	}
+	if !*wait {
+		result, _, err := service.ManualReconcileWithContext(ctx, service.NewManualReconcileOptions())
+		if err != nil {
+			return err
+		}
+		return c.printValue(*output, result)
+	}
+
+	waitOptions := service.NewWaitForCollectionCompleteOptions().
+		SetProgressCallback(func(attempt int64, status *configurationaggregatorv1.StatusResponse) {
+			fmt.Fprintf(c.stderr, "Waiting for the collection to complete (check %d, status %s)\n", attempt, core.StringNilMapper(status.Status))
+		})
+	if *interval > 0 {
+		waitOptions.SetInterval(*interval)
+	}
+	if *timeout > 0 {
+		waitOptions.SetTimeout(*timeout)
+	}
+	result, err := service.ManualReconcileAndWait(ctx, service.NewManualReconcileAndWaitOptions().SetWaitOptions(waitOptions))
+	if err != nil {
+		return err
+	}
+	return c.printValue(*output, &reconcileOutput{Message: result.Message, Status: result.Status})
+}
@@ -0,0 +1,207 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"iter"
+	"strings"
+
+	"github.com/IBM/configuration-aggregator-go-sdk/configurationaggregatorv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+const configsListUsage = `Usage: configaggregator configs list [flags]
+
+Lists the resource configurations matching the filters. All the pages are retrieved unless --single-page is set.
+
+Filters:
+  --config-type string        The type of resource configuration
+  --service-name string       The name of the IBM Cloud service
+  --resource-group-id string  The resource group ID
+  --location string           The location or region
+  --resource-crn string       The CRN of the resource
+  --sub-account string        The sub-account in an enterprise hierarchy
+  --access-tags string        The access tags
+  --user-tags string          The user tags
+  --service-tags string       The service tags
+  --query string              A query applied to the results on the client side, e.g. 'config.encryption.enabled == false'
+
+Pagination:
+  --limit int                 The number of resources per page
+  --start string              The start of the first page to retrieve, from a previous response
+  --single-page               Retrieve a single page
+
+Output:
+  --output string             The output format: json, ndjson, yaml or csv (default "json")
+  --column expression         A CSV column, as a query expression or header=expression; may be repeated
+`
+
+func (c *cli) configsList(ctx context.Context, args []string) error {
+	flags := c.newFlagSet("configs list", configsListUsage)
+	var configType, serviceName, resourceGroupID, location, resourceCrn, subAccount, accessTags, userTags, serviceTags, start, query, output string
+	var limit int64
+	var singlePage bool
+	var columns []string
+	flags.StringVar(&configType, "config-type", "", "")
+	flags.StringVar(&serviceName, "service-name", "", "")
+	flags.StringVar(&resourceGroupID, "resource-group-id", "", "")
+	flags.StringVar(&location, "location", "", "")
+	flags.StringVar(&resourceCrn, "resource-crn", "", "")
+	flags.StringVar(&subAccount, "sub-account", "", "")
+	flags.StringVar(&accessTags, "access-tags", "", "")
+	flags.StringVar(&userTags, "user-tags", "", "")
+	flags.StringVar(&serviceTags, "service-tags", "", "")
+	flags.StringVar(&query, "query", "", "")
+	flags.Int64Var(&limit, "limit", 0, "")
+	flags.StringVar(&start, "start", "", "")
+	flags.BoolVar(&singlePage, "single-page", false, "")
+	flags.StringVar(&output, "output", outputJSON, "")
+	flags.Func("column", "", func(value string) error {
+		columns = append(columns, value)
+		return nil
+	})
+	if err := c.parseFlags(flags, configsListUsage, args); err != nil {
+		return err
+	}
+
+	listConfigsOptions := &configurationaggregatorv1.ListConfigsOptions{
+		ConfigType:      optionalString(configType),
+		ServiceName:     optionalString(serviceName),
+		ResourceGroupID: optionalString(resourceGroupID),
+		Location:        optionalString(location),
+		ResourceCrn:     optionalString(resourceCrn),
+		SubAccount:      optionalString(subAccount),
+		AccessTags:      optionalString(accessTags),
+		UserTags:        optionalString(userTags),
+		ServiceTags:     optionalString(serviceTags),
+		Start:           optionalString(start),
+	}
+	if limit > 0 {
+		listConfigsOptions.Limit = core.Int64Ptr(limit)
+	}
+
+	var configQuery *configurationaggregatorv1.ConfigQuery
+	if query != "" {
+		var err error
+		configQuery, err = configurationaggregatorv1.CompileConfigQuery(query)
+		if err != nil {
+			return err
+		}
+	}
+	writer, err := c.newConfigsWriter(output, columns)
+	if err != nil {
+		return err
+	}
+
+	service, err := c.newService()
+	if err != nil {
+		return err
+	}
+	var seq iter.Seq2[configurationaggregatorv1.Config, error]
+	if singlePage || listConfigsOptions.Start != nil {
+		seq = pagesSeq(ctx, service, listConfigsOptions, singlePage)
+	} else {
+		seq = service.AllConfigs(ctx, listConfigsOptions)
+	}
+	if configQuery != nil {
+		seq = configQuery.Filter(seq)
+	}
+	_, err = configurationaggregatorv1.ExportConfigs(seq, writer)
+	return err
+}
+
+// pagesSeq returns the resource configurations of the pages starting at the Start of the options, or at the first
+// page if it is not set. Only the first of these pages is retrieved if singlePage is set. The pages are retrieved
+// without a pager, as a pager always starts at the first page.
+func pagesSeq(ctx context.Context, service *configurationaggregatorv1.ConfigurationAggregatorV1, listConfigsOptions *configurationaggregatorv1.ListConfigsOptions, singlePage bool) iter.Seq2[configurationaggregatorv1.Config, error] {
+	return func(yield func(configurationaggregatorv1.Config, error) bool) {
+		options := *listConfigsOptions
+		for {
+			result, _, err := service.ListConfigsWithContext(ctx, &options)
+			if err != nil {
+				yield(configurationaggregatorv1.Config{}, err)
+				return
+			}
+			for _, config := range result.Configs {
+				if !yield(config, nil) {
+					return
+				}
+			}
+			next, _ := result.GetNextStart()
+			if singlePage || next == nil {
+				return
+			}
+			options.Start = next
+		}
+	}
+}
+
+// newConfigsWriter returns the writer of the output format. The columns are specified as "expression" or
+// "header=expression".
+func (c *cli) newConfigsWriter(output string, columns []string) (configurationaggregatorv1.ConfigsWriter, error) {
+	if output == outputJSON {
+		return &jsonArrayWriter{w: c.stdout}, nil
+	}
+	var exportColumns []configurationaggregatorv1.ExportColumn
+	for _, column := range columns {
+		exportColumns = append(exportColumns, parseColumn(column))
+	}
+	return configurationaggregatorv1.NewConfigsWriter(output, c.stdout, exportColumns)
+}
+
+// parseColumn parses a column of the form "expression" or "header=expression". An "=" that is part of an
+// operator of the expression, e.g. "==", does not separate a header.
+func parseColumn(column string) configurationaggregatorv1.ExportColumn {
+	header, expression, found := strings.Cut(column, "=")
+	if !found || strings.HasPrefix(expression, "=") || strings.ContainsAny(header, " !<>\"") {
+		return configurationaggregatorv1.ExportColumn{Header: column, Expression: column}
+	}
+	return configurationaggregatorv1.ExportColumn{Header: header, Expression: expression}
+}
+
+// jsonArrayWriter writes the resource configurations as an indented JSON array, one at a time.
+type jsonArrayWriter struct {
+	w     io.Writer
+	count int64
+}
+
+func (writer *jsonArrayWriter) Write(config *configurationaggregatorv1.Config) error {
+	data, err := json.MarshalIndent(config, "  ", "  ")
+	if err != nil {
+		return err
+	}
+	separator := ",\n  "
+	if writer.count == 0 {
+		separator = "[\n  "
+	}
+	writer.count++
+	_, err = fmt.Fprintf(writer.w, "%s%s", separator, data)
+	return err
+}
+
+func (writer *jsonArrayWriter) Close() error {
+	closing := "\n]\n"
+	if writer.count == 0 {
+		closing = "[]\n"
+	}
+	_, err := io.WriteString(writer.w, closing)
+	return err
+}
@@ -0,0 +1,269 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Command configaggregator is a command-line client of the IBM Cloud Configuration Aggregator service.
+//
+// The credentials, region and instance ID are read from the environment or from a credentials file, as by
+// configurationaggregatorv1.NewConfigurationAggregatorV1UsingExternalConfig(), e.g.:
+//
+//	CONFIGURATION_AGGREGATOR_AUTH_TYPE=iam
+//	CONFIGURATION_AGGREGATOR_APIKEY=<IBM_CLOUD_API_KEY>
+//	CONFIGURATION_AGGREGATOR_REGION=us-south
+//	CONFIGURATION_AGGREGATOR_INSTANCE_ID=<instance_id>
+//
+// Run "configaggregator help" for the list of commands.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+
+	"github.com/IBM/configuration-aggregator-go-sdk/configurationaggregatorv1"
+	"sigs.k8s.io/yaml"
+)
+
+const usage = `Usage: configaggregator [global flags] <command> [flags]
+
+Commands:
+  configs list              List the resource configurations
+  settings get              Get the settings
+  settings replace          Replace the settings
+  settings apply            Update the settings, keeping the values that are not specified
+  status                    Get the status of the resource collection
+  reconcile [--wait]        Trigger a resource collection, and optionally wait for it to complete
+
+Global flags:
+  --config-name string      The name of the service in the external configuration (default "configuration_aggregator")
+  --url string              The URL of the service, overriding the region and instance ID
+  --region string           The region of the App Configuration instance
+  --instance-id string      The ID of the App Configuration instance
+
+Run "configaggregator <command> --help" for the flags of a command.
+`
+
+// errUsage is returned for invalid command lines, after the usage has been printed.
+var errUsage = errors.New("invalid usage")
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	os.Exit(run(ctx, os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// cli holds the state of an invocation of the command.
+type cli struct {
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+
+	configName string
+	url        string
+	region     string
+	instanceID string
+}
+
+// run runs the command line and returns the exit code: 0 on success or --help, 1 on error and 2 on invalid usage.
+func run(ctx context.Context, args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+	c := &cli{stdin: stdin, stdout: stdout, stderr: stderr}
+	err := c.run(ctx, args)
+	switch {
+	case err == nil, errors.Is(err, flag.ErrHelp):
+		return 0
+	case errors.Is(err, errUsage):
+		return 2
+	}
+	fmt.Fprintf(stderr, "Error: %s\n", err.Error())
+	return 1
+}
+
+func (c *cli) run(ctx context.Context, args []string) error {
+	flags := c.newFlagSet("configaggregator", usage)
+	flags.StringVar(&c.configName, "config-name", configurationaggregatorv1.DefaultServiceName, "")
+	flags.StringVar(&c.url, "url", "", "")
+	flags.StringVar(&c.region, "region", "", "")
+	flags.StringVar(&c.instanceID, "instance-id", "", "")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	args = flags.Args()
+	if len(args) == 0 {
+		return c.usageError(usage, "a command must be specified")
+	}
+
+	command, args := args[0], args[1:]
+	switch command {
+	case "configs":
+		return c.runSubcommand(ctx, command, args, map[string]func(context.Context, []string) error{
+			"list": c.configsList,
+		})
+	case "settings":
+		return c.runSubcommand(ctx, command, args, map[string]func(context.Context, []string) error{
+			"get":     c.settingsGet,
+			"replace": c.settingsReplace,
+			"apply":   c.settingsApply,
+		})
+	case "status":
+		return c.status(ctx, args)
+	case "reconcile":
+		return c.reconcile(ctx, args)
+	case "help":
+		fmt.Fprint(c.stdout, usage)
+		return nil
+	}
+	return c.usageError(usage, "unknown command '%s'", command)
+}
+
+func (c *cli) runSubcommand(ctx context.Context, command string, args []string, subcommands map[string]func(context.Context, []string) error) error {
+	if len(args) == 0 {
+		return c.usageError(usage, "a subcommand of '%s' must be specified", command)
+	}
+	subcommand, found := subcommands[args[0]]
+	if !found {
+		return c.usageError(usage, "unknown command '%s %s'", command, args[0])
+	}
+	return subcommand(ctx, args[1:])
+}
+
+// usageError prints the message and the usage, and returns errUsage.
+func (c *cli) usageError(usage string, format string, args ...interface{}) error {
+	fmt.Fprintf(c.stderr, "Error: %s\n\n%s", fmt.Sprintf(format, args...), usage)
+	return errUsage
+}
+
+// newFlagSet returns a flag set that prints the specified usage.
+func (c *cli) newFlagSet(name string, usage string) *flag.FlagSet {
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	flags.SetOutput(c.stderr)
+	flags.Usage = func() {
+		fmt.Fprint(c.stderr, usage)
+	}
+	return flags
+}
+
+// parseFlags parses the flags of a command, which takes no positional arguments.
+func (c *cli) parseFlags(flags *flag.FlagSet, usage string, args []string) error {
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() > 0 {
+		return c.usageError(usage, "unexpected argument '%s'", flags.Arg(0))
+	}
+	return nil
+}
+
+// newService returns a client configured from the global flags and the external configuration.
+func (c *cli) newService() (*configurationaggregatorv1.ConfigurationAggregatorV1, error) {
+	return configurationaggregatorv1.NewConfigurationAggregatorV1UsingExternalConfig(&configurationaggregatorv1.ConfigurationAggregatorV1Options{
+		ServiceName: c.configName,
+		URL:         c.url,
+		Region:      c.region,
+		InstanceID:  c.instanceID,
+	})
+}
+
+// Constants associated with the output formats of the commands, besides the export formats of "configs list".
+const (
+	outputJSON = "json"
+	outputYAML = "yaml"
+)
+
+// checkOutput returns an error if the output format is not supported, so that it is reported before any request.
+func checkOutput(format string) error {
+	if format != outputJSON && format != outputYAML {
+		return fmt.Errorf("unsupported output format '%s', supported formats are: %s, %s", format, outputJSON, outputYAML)
+	}
+	return nil
+}
+
+// printValue prints a response in the specified format.
+func (c *cli) printValue(format string, value interface{}) error {
+	if err := checkOutput(format); err != nil {
+		return err
+	}
+	var data []byte
+	var err error
+	if format == outputYAML {
+		data, err = yaml.Marshal(value)
+	} else {
+		data, err = json.MarshalIndent(value, "", "  ")
+		data = append(data, '\n')
+	}
+	if err != nil {
+		return err
+	}
+	_, err = c.stdout.Write(data)
+	return err
+}
+
+// stringsFlag is a flag that may be repeated, or hold values separated by commas.
+type stringsFlag []string
+
+func (f *stringsFlag) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *stringsFlag) Set(value string) error {
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			*f = append(*f, v)
+		}
+	}
+	return nil
+}
+
+// optionalBoolFlag is a boolean flag that is nil unless it is specified.
+type optionalBoolFlag struct {
+	value *bool
+}
+
+func (f *optionalBoolFlag) String() string {
+	if f.value == nil {
+		return ""
+	}
+	return fmt.Sprint(*f.value)
+}
+
+func (f *optionalBoolFlag) Set(value string) error {
+	switch strings.ToLower(value) {
+	case "true", "1", "yes":
+		f.value = new(bool)
+		*f.value = true
+	case "false", "0", "no":
+		f.value = new(bool)
+	default:
+		return fmt.Errorf("invalid boolean value '%s'", value)
+	}
+	return nil
+}
+
+func (f *optionalBoolFlag) IsBoolFlag() bool {
+	return true
+}
+
+// optionalString returns a pointer to the value, or nil if it is empty.
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
@@ -0,0 +1,214 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testConfigJSON = `{"about":{"account_id":"AccountID","config_type":"ConfigType","resource_crn":"%s","resource_group_id":"ResourceGroupID","service_name":"kms","resource_name":"%s-name","last_config_refresh_time":"2019-01-01T12:00:00.000Z","location":"us-south","user_tags":["env:prod"]},"config":{"rotation":{"enabled":%t}}}`
+
+// newTestServer returns a server that emulates the service, and records the settings it receives.
+func newTestServer(t *testing.T, putSettings *[]map[string]interface{}) *httptest.Server {
+	settings := `{"resource_collection_enabled":true,"trusted_profile_id":"Profile-5f2b4d5c-9a8e-4a5b-9c3b-2f1d6e7a8b9c","regions":["us-south"],"last_updated":"2026-01-01T00:00:00.000Z"}`
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		res.Header().Set("Content-type", "application/json")
+		switch {
+		case req.URL.Path == "/configs" && req.URL.Query().Get("start") == "":
+			assert.Equal(t, "kms", req.URL.Query().Get("service_name"))
+			fmt.Fprintf(res, `{"next":{"start":"2"},"configs":[%s,%s],"limit":2}`,
+				fmt.Sprintf(testConfigJSON, "crn1", "crn1", true), fmt.Sprintf(testConfigJSON, "crn2", "crn2", false))
+		case req.URL.Path == "/configs" && req.URL.Query().Get("start") == "2":
+			fmt.Fprintf(res, `{"next":{"start":"3"},"configs":[%s],"limit":2}`, fmt.Sprintf(testConfigJSON, "crn3", "crn3", false))
+		case req.URL.Path == "/configs":
+			fmt.Fprintf(res, `{"configs":[%s],"limit":2}`, fmt.Sprintf(testConfigJSON, "crn4", "crn4", true))
+		case req.URL.Path == "/settings" && req.Method == http.MethodGet:
+			fmt.Fprint(res, settings)
+		case req.URL.Path == "/settings" && req.Method == http.MethodPut:
+			var body map[string]interface{}
+			assert.Nil(t, json.NewDecoder(req.Body).Decode(&body))
+			*putSettings = append(*putSettings, body)
+			fmt.Fprint(res, settings)
+		case req.URL.Path == "/resource_collection_status":
+			fmt.Fprint(res, `{"last_config_refresh_time":"2026-01-01T00:00:00.000Z","status":"complete"}`)
+		case req.URL.Path == "/reconcile":
+			res.WriteHeader(http.StatusAccepted)
+			fmt.Fprint(res, `{"message":"Reconciliation initiated"}`)
+		default:
+			res.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	t.Setenv("CONFIGURATION_AGGREGATOR_AUTH_TYPE", "noauth")
+	return server
+}
+
+func runCommand(stdin io.Reader, args ...string) (code int, stdout string, stderr string) {
+	var out, errOut bytes.Buffer
+	code = run(context.Background(), args, stdin, &out, &errOut)
+	return code, out.String(), errOut.String()
+}
+
+func TestConfigsList(t *testing.T) {
+	server := newTestServer(t, nil)
+
+	code, stdout, stderr := runCommand(nil, "--url", server.URL, "configs", "list", "--service-name", "kms")
+	assert.Equal(t, 0, code, stderr)
+	var configs []map[string]interface{}
+	assert.Nil(t, json.Unmarshal([]byte(stdout), &configs))
+	assert.Len(t, configs, 4)
+
+	code, stdout, stderr = runCommand(nil, "--url", server.URL, "configs", "list", "--service-name", "kms",
+		"--query", "config.rotation.enabled == false", "--output", "csv", "--column", "crn=resource_crn", "--column", "config.rotation.enabled")
+	assert.Equal(t, 0, code, stderr)
+	assert.Equal(t, "crn,config.rotation.enabled\ncrn2,false\ncrn3,false\n", stdout)
+
+	code, stdout, stderr = runCommand(nil, "--url", server.URL, "configs", "list", "--service-name", "kms", "--single-page", "--output", "ndjson")
+	assert.Equal(t, 0, code, stderr)
+	assert.Equal(t, 2, strings.Count(stdout, "\n"))
+
+	code, stdout, stderr = runCommand(nil, "--url", server.URL, "configs", "list", "--start", "2", "--output", "csv", "--column", "resource_crn")
+	assert.Equal(t, 0, code, stderr)
+	assert.Equal(t, "resource_crn\ncrn3\ncrn4\n", stdout)
+
+	code, stdout, stderr = runCommand(nil, "--url", server.URL, "configs", "list", "--start", "2", "--single-page", "--output", "csv", "--column", "resource_crn")
+	assert.Equal(t, 0, code, stderr)
+	assert.Equal(t, "resource_crn\ncrn3\n", stdout)
+
+	code, _, stderr = runCommand(nil, "--url", server.URL, "configs", "list", "--output", "xml")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "unsupported export format 'xml'")
+}
+
+func TestSettings(t *testing.T) {
+	var putSettings []map[string]interface{}
+	server := newTestServer(t, &putSettings)
+
+	code, stdout, stderr := runCommand(nil, "--url", server.URL, "settings", "get", "--output", "yaml")
+	assert.Equal(t, 0, code, stderr)
+	assert.Contains(t, stdout, "- us-south\n")
+
+	code, stdout, stderr = runCommand(nil, "--url", server.URL, "settings", "apply", "--collection-region", "us-south,eu-de", "--dry-run")
+	assert.Equal(t, 0, code, stderr)
+	assert.Contains(t, stdout, `"applied": false`)
+	assert.Contains(t, stdout, `"path": "regions"`)
+	assert.Empty(t, putSettings)
+
+	code, _, stderr = runCommand(nil, "--url", server.URL, "settings", "apply", "--collection-region", "us-south,eu-de")
+	assert.Equal(t, 0, code, stderr)
+	assert.Len(t, putSettings, 1)
+	assert.Equal(t, []interface{}{"us-south", "eu-de"}, putSettings[0]["regions"])
+	assert.Equal(t, true, putSettings[0]["resource_collection_enabled"])
+
+	file := filepath.Join(t.TempDir(), "settings.yaml")
+	assert.Nil(t, os.WriteFile(file, []byte("resource_collection_enabled: true\nregions: [eu-gb]\n"), 0o600))
+	code, _, stderr = runCommand(nil, "--url", server.URL, "settings", "replace", "--file", file, "--resource-collection-enabled=false")
+	assert.Equal(t, 0, code, stderr)
+	assert.Len(t, putSettings, 2)
+	assert.Equal(t, map[string]interface{}{"resource_collection_enabled": false, "regions": []interface{}{"eu-gb"}}, putSettings[1])
+
+	code, _, stderr = runCommand(strings.NewReader(`{"regions":["mars-1"]}`), "--url", server.URL, "settings", "replace", "--file", "-")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "regions[0]: 'mars-1' is not a supported region")
+	assert.Len(t, putSettings, 2)
+}
+
+func TestServiceURL(t *testing.T) {
+	t.Setenv("CONFIGURATION_AGGREGATOR_AUTH_TYPE", "noauth")
+	t.Setenv("CONFIGURATION_AGGREGATOR_REGION", "eu-de")
+	t.Setenv("CONFIGURATION_AGGREGATOR_INSTANCE_ID", "envInstance")
+
+	c := &cli{configName: "configuration_aggregator", instanceID: "flagInstance"}
+	service, err := c.newService()
+	assert.Nil(t, err)
+	assert.Equal(t, "https://eu-de.apprapp.cloud.ibm.com/apprapp/config_aggregator/v1/instances/flagInstance", service.GetServiceURL())
+
+	c = &cli{configName: "configuration_aggregator", region: "jp-tok"}
+	service, err = c.newService()
+	assert.Nil(t, err)
+	assert.Equal(t, "https://jp-tok.apprapp.cloud.ibm.com/apprapp/config_aggregator/v1/instances/envInstance", service.GetServiceURL())
+}
+
+func TestStatusAndReconcile(t *testing.T) {
+	server := newTestServer(t, nil)
+
+	code, stdout, stderr := runCommand(nil, "--url", server.URL, "status")
+	assert.Equal(t, 0, code, stderr)
+	assert.Contains(t, stdout, `"status": "complete"`)
+
+	code, stdout, stderr = runCommand(nil, "--url", server.URL, "reconcile")
+	assert.Equal(t, 0, code, stderr)
+	assert.Contains(t, stdout, `"message": "Reconciliation initiated"`)
+}
+
+func TestReconcileAndWait(t *testing.T) {
+	var statusRequests int
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		res.Header().Set("Content-type", "application/json")
+		switch req.URL.Path {
+		case "/resource_collection_status":
+			statusRequests++
+			fmt.Fprintf(res, `{"last_config_refresh_time":"2026-01-01T00:00:%02d.000Z","status":"complete"}`, statusRequests)
+		case "/reconcile":
+			res.WriteHeader(http.StatusAccepted)
+			fmt.Fprint(res, `{"message":"Reconciliation initiated"}`)
+		default:
+			res.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	t.Setenv("CONFIGURATION_AGGREGATOR_AUTH_TYPE", "noauth")
+
+	code, stdout, stderr := runCommand(nil, "--url", server.URL, "reconcile", "--wait", "--interval", "2m")
+	assert.Equal(t, 0, code, stderr)
+	assert.Contains(t, stdout, `"last_config_refresh_time": "2026-01-01T00:00:02.000Z"`)
+	assert.Equal(t, 2, statusRequests)
+}
+
+func TestUsage(t *testing.T) {
+	code, _, stderr := runCommand(nil)
+	assert.Equal(t, 2, code)
+	assert.Contains(t, stderr, "a command must be specified")
+
+	code, _, stderr = runCommand(nil, "settings", "delete")
+	assert.Equal(t, 2, code)
+	assert.Contains(t, stderr, "unknown command 'settings delete'")
+
+	code, _, stderr = runCommand(nil, "status", "--help")
+	assert.Equal(t, 0, code)
+	assert.Contains(t, stderr, "Usage: configaggregator status")
+
+	code, stdout, _ := runCommand(nil, "help")
+	assert.Equal(t, 0, code)
+	assert.Contains(t, stdout, "configs list")
+
+	assert.Equal(t, "total", parseColumn("total=len(user_tags)").Header)
+	assert.Equal(t, "config.a == 1", parseColumn("config.a == 1").Header)
+	assert.Equal(t, "config.a>=1", parseColumn("config.a>=1").Expression)
+}
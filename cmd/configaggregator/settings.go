@@ -0,0 +1,216 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/IBM/configuration-aggregator-go-sdk/configurationaggregatorv1"
+	"sigs.k8s.io/yaml"
+)
+
+const settingsGetUsage = `Usage: configaggregator settings get [flags]
+
+Gets the settings.
+
+Flags:
+  --output string                   The output format: json or yaml (default "json")
+`
+
+const settingsReplaceUsage = `Usage: configaggregator settings replace [flags]
+
+Replaces the settings. The properties that are not specified are cleared.
+
+Flags:
+  --file string                     A JSON or YAML file holding the settings, or "-" for the standard input
+  --resource-collection-enabled     Whether the resource collection is enabled
+  --trusted-profile-id string       The trusted profile ID
+  --collection-region string        A region across which the resource collection is enabled; may be repeated
+  --skip-validation                 Send the settings without validating them on the client side
+  --output string                   The output format: json or yaml (default "json")
+
+The flags override the properties of the file.
+`
+
+const settingsApplyUsage = `Usage: configaggregator settings apply [flags]
+
+Updates the settings with the specified properties, keeping the current value of the others, and prints the changes.
+The settings are only replaced if they differ from the current settings.
+
+Flags:
+  --file string                     A JSON or YAML file holding the settings, or "-" for the standard input
+  --resource-collection-enabled     Whether the resource collection is enabled
+  --trusted-profile-id string       The trusted profile ID
+  --collection-region string        A region across which the resource collection is enabled; may be repeated
+  --skip-validation                 Send the settings without validating them on the client side
+  --dry-run                         Print the changes without applying them
+  --output string                   The output format: json or yaml (default "json")
+
+The flags override the properties of the file.
+`
+
+func (c *cli) settingsGet(ctx context.Context, args []string) error {
+	flags := c.newFlagSet("settings get", settingsGetUsage)
+	output := flags.String("output", outputJSON, "")
+	if err := c.parseFlags(flags, settingsGetUsage, args); err != nil {
+		return err
+	}
+	if err := checkOutput(*output); err != nil {
+		return err
+	}
+
+	service, err := c.newService()
+	if err != nil {
+		return err
+	}
+	settings, _, err := service.GetSettingsWithContext(ctx, service.NewGetSettingsOptions())
+	if err != nil {
+		return err
+	}
+	return c.printValue(*output, settings)
+}
+
+// settingsFlags are the flags that specify settings.
+type settingsFlags struct {
+	file                      string
+	resourceCollectionEnabled optionalBoolFlag
+	trustedProfileID          string
+	regions                   stringsFlag
+	skipValidation            bool
+}
+
+func (f *settingsFlags) register(flags *flag.FlagSet) {
+	flags.StringVar(&f.file, "file", "", "")
+	flags.Var(&f.resourceCollectionEnabled, "resource-collection-enabled", "")
+	flags.StringVar(&f.trustedProfileID, "trusted-profile-id", "", "")
+	flags.Var(&f.regions, "collection-region", "")
+	flags.BoolVar(&f.skipValidation, "skip-validation", false, "")
+}
+
+// settings returns the settings read from the file, if any, with the properties specified by flags applied.
+// The settings are validated unless --skip-validation is set.
+func (c *cli) settings(f *settingsFlags) (*configurationaggregatorv1.ReplaceSettingsOptions, error) {
+	settings := new(configurationaggregatorv1.ReplaceSettingsOptions)
+	if f.file != "" {
+		var data []byte
+		var err error
+		if f.file == "-" {
+			data, err = io.ReadAll(c.stdin)
+		} else {
+			data, err = os.ReadFile(f.file)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if err = yaml.UnmarshalStrict(data, settings); err != nil {
+			return nil, fmt.Errorf("invalid settings file '%s': %s", f.file, err.Error())
+		}
+		settings.Headers = nil
+	}
+	if f.resourceCollectionEnabled.value != nil {
+		settings.ResourceCollectionEnabled = f.resourceCollectionEnabled.value
+	}
+	if f.trustedProfileID != "" {
+		settings.TrustedProfileID = &f.trustedProfileID
+	}
+	if len(f.regions) > 0 {
+		settings.Regions = f.regions
+	}
+	if !f.skipValidation {
+		if err := settings.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	return settings, nil
+}
+
+func (c *cli) settingsReplace(ctx context.Context, args []string) error {
+	flags := c.newFlagSet("settings replace", settingsReplaceUsage)
+	var input settingsFlags
+	input.register(flags)
+	output := flags.String("output", outputJSON, "")
+	if err := c.parseFlags(flags, settingsReplaceUsage, args); err != nil {
+		return err
+	}
+	if err := checkOutput(*output); err != nil {
+		return err
+	}
+	if input.file == "" && input.resourceCollectionEnabled.value == nil && input.trustedProfileID == "" && len(input.regions) == 0 {
+		return c.usageError(settingsReplaceUsage, "the settings must be specified with --file or flags")
+	}
+	settings, err := c.settings(&input)
+	if err != nil {
+		return err
+	}
+
+	service, err := c.newService()
+	if err != nil {
+		return err
+	}
+	result, _, err := service.ReplaceSettingsWithContext(ctx, settings)
+	if err != nil {
+		return err
+	}
+	return c.printValue(*output, result)
+}
+
+// applySettingsOutput is the output of "settings apply".
+type applySettingsOutput struct {
+	Applied  bool                                        `json:"applied"`
+	Changes  []configurationaggregatorv1.ValueChange     `json:"changes"`
+	Settings *configurationaggregatorv1.SettingsResponse `json:"settings"`
+}
+
+func (c *cli) settingsApply(ctx context.Context, args []string) error {
+	flags := c.newFlagSet("settings apply", settingsApplyUsage)
+	var input settingsFlags
+	input.register(flags)
+	dryRun := flags.Bool("dry-run", false, "")
+	output := flags.String("output", outputJSON, "")
+	if err := c.parseFlags(flags, settingsApplyUsage, args); err != nil {
+		return err
+	}
+	if err := checkOutput(*output); err != nil {
+		return err
+	}
+	settings, err := c.settings(&input)
+	if err != nil {
+		return err
+	}
+
+	service, err := c.newService()
+	if err != nil {
+		return err
+	}
+	result, err := service.ApplySettings(ctx, service.NewApplySettingsOptions(settings).SetDryRun(*dryRun))
+	if err != nil {
+		return err
+	}
+	changes := result.Changes
+	if changes == nil {
+		changes = []configurationaggregatorv1.ValueChange{}
+	}
+	return c.printValue(*output, &applySettingsOutput{
+		Applied:  result.Applied,
+		Changes:  changes,
+		Settings: result.Settings,
+	})
+}
@@ -0,0 +1,116 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configurationaggregatorv1
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// RequestEvent : A request of an operation, as seen by the hooks of a Middleware.
+type RequestEvent struct {
+	// The name of the operation, e.g. "ListConfigs".
+	Operation string
+
+	// The request. Its context is the context of the operation. BeforeRequest hooks may modify it, e.g. to add
+	// headers.
+	Request *http.Request
+
+	// The response, set for the AfterResponse and OnError hooks when a response was received, including error
+	// responses.
+	Response *core.DetailedResponse
+
+	// The error, set for the OnError hooks. For an error response of the service, it is a *ServiceError.
+	Err error
+
+	// The time between the sending of the request and the reception of the response, set for the AfterResponse and
+	// OnError hooks.
+	Duration time.Duration
+}
+
+// Middleware : Hooks invoked around the requests of the operations, e.g. to add correlation IDs, inject headers,
+// log requests or collect metrics. Any hook may be nil.
+//
+// The BeforeRequest hooks are invoked in registration order, and the AfterResponse and OnError hooks in reverse
+// registration order, so that the first registered middleware is the outermost one.
+type Middleware struct {
+	// Invoked before the request is sent. Returning an error aborts the request: the operation fails with this
+	// error, after the OnError hooks of this middleware and of the ones registered before it.
+	BeforeRequest func(event *RequestEvent) error
+
+	// Invoked when a response is received, including error responses, before the OnError hooks.
+	AfterResponse func(event *RequestEvent)
+
+	// Invoked when the request fails, whether or not a response was received.
+	OnError func(event *RequestEvent)
+}
+
+// Use registers middlewares invoked around the requests of all the operations of the client, including those of
+// pagers. The middlewares must be registered before the client is used concurrently. A clone of the client has the
+// middlewares registered on the original at cloning time.
+func (configurationAggregator *ConfigurationAggregatorV1) Use(middlewares ...Middleware) {
+	// The capacity is capped so that the slice is copied, and clones never share the appended middlewares.
+	registered := configurationAggregator.middlewares
+	configurationAggregator.middlewares = append(registered[:len(registered):len(registered)], middlewares...)
+}
+
+// request sends a request of an operation through the middlewares of the client.
+func (configurationAggregator *ConfigurationAggregatorV1) request(operation string, request *http.Request, result interface{}) (response *core.DetailedResponse, err error) {
+	middlewares := configurationAggregator.middlewares
+	if len(middlewares) == 0 {
+		return configurationAggregator.Service.Request(request, result)
+	}
+
+	event := &RequestEvent{Operation: operation, Request: request}
+	for i, middleware := range middlewares {
+		if middleware.BeforeRequest == nil {
+			continue
+		}
+		if err = middleware.BeforeRequest(event); err != nil {
+			event.Err = err
+			for j := i; j >= 0; j-- {
+				if middlewares[j].OnError != nil {
+					middlewares[j].OnError(event)
+				}
+			}
+			return
+		}
+	}
+
+	start := time.Now()
+	response, err = configurationAggregator.Service.Request(event.Request, result)
+	event.Duration = time.Since(start)
+	event.Response = response
+	if response != nil {
+		for i := len(middlewares) - 1; i >= 0; i-- {
+			if middlewares[i].AfterResponse != nil {
+				middlewares[i].AfterResponse(event)
+			}
+		}
+	}
+	if err != nil {
+		event.Err = newServiceError(err)
+		for i := len(middlewares) - 1; i >= 0; i-- {
+			if middlewares[i].OnError != nil {
+				middlewares[i].OnError(event)
+			}
+		}
+	}
+	return
+}
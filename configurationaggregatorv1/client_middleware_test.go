@@ -0,0 +1,154 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configurationaggregatorv1_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/configuration-aggregator-go-sdk/configurationaggregatorv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ConfigurationAggregatorV1 middlewares`, func() {
+	var testServer *httptest.Server
+	var configurationAggregatorService *configurationaggregatorv1.ConfigurationAggregatorV1
+	BeforeEach(func() {
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			res.Header().Set("Content-type", "application/json")
+			res.Header().Set("X-Correlation-Id", req.Header.Get("X-Correlation-Id"))
+			switch req.URL.Path {
+			case "/configs":
+				if req.URL.Query().Get("start") == "" {
+					fmt.Fprint(res, `{"limit":1,"next":{"start":"1"},"configs":[{"about":{"resource_crn":"crn1"}}]}`)
+					return
+				}
+				fmt.Fprint(res, `{"limit":1,"configs":[{"about":{"resource_crn":"crn2"}}]}`)
+			case "/settings":
+				res.WriteHeader(403)
+				fmt.Fprint(res, `{"errors":[{"code":"forbidden","message":"Access denied"}]}`)
+			default:
+				res.WriteHeader(404)
+			}
+		}))
+		var err error
+		configurationAggregatorService, err = configurationaggregatorv1.NewConfigurationAggregatorV1(&configurationaggregatorv1.ConfigurationAggregatorV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Invoke the hooks in order around the requests, including the pages of a pager`, func() {
+		var calls []string
+		for _, name := range []string{"outer", "inner"} {
+			name := name
+			configurationAggregatorService.Use(configurationaggregatorv1.Middleware{
+				BeforeRequest: func(event *configurationaggregatorv1.RequestEvent) error {
+					calls = append(calls, fmt.Sprintf("%s before %s %s", name, event.Operation, event.Request.URL.Query().Get("start")))
+					event.Request.Header.Set("X-Correlation-Id", "correlation-id")
+					return nil
+				},
+				AfterResponse: func(event *configurationaggregatorv1.RequestEvent) {
+					calls = append(calls, fmt.Sprintf("%s after %d %s", name, event.Response.StatusCode, event.Response.Headers.Get("X-Correlation-Id")))
+				},
+				OnError: func(event *configurationaggregatorv1.RequestEvent) {
+					calls = append(calls, fmt.Sprintf("%s error", name))
+				},
+			})
+		}
+
+		pager, err := configurationAggregatorService.NewConfigsPager(configurationAggregatorService.NewListConfigsOptions())
+		Expect(err).To(BeNil())
+		configs, err := pager.GetAll()
+		Expect(err).To(BeNil())
+		Expect(configs).To(HaveLen(2))
+		Expect(calls).To(Equal([]string{
+			"outer before ListConfigs ",
+			"inner before ListConfigs ",
+			"inner after 200 correlation-id",
+			"outer after 200 correlation-id",
+			"outer before ListConfigs 1",
+			"inner before ListConfigs 1",
+			"inner after 200 correlation-id",
+			"outer after 200 correlation-id",
+		}))
+	})
+	It(`Invoke the error hooks with the service error`, func() {
+		var events []configurationaggregatorv1.RequestEvent
+		configurationAggregatorService.Use(configurationaggregatorv1.Middleware{
+			AfterResponse: func(event *configurationaggregatorv1.RequestEvent) {
+				events = append(events, *event)
+			},
+			OnError: func(event *configurationaggregatorv1.RequestEvent) {
+				events = append(events, *event)
+			},
+		})
+
+		_, _, err := configurationAggregatorService.GetSettings(configurationAggregatorService.NewGetSettingsOptions())
+		Expect(errors.Is(err, configurationaggregatorv1.ErrForbidden)).To(BeTrue())
+		Expect(events).To(HaveLen(2))
+		Expect(events[0].Operation).To(Equal("GetSettings"))
+		Expect(events[0].Response.StatusCode).To(Equal(403))
+		Expect(events[0].Err).To(BeNil())
+		Expect(events[1].Duration).To(BeNumerically(">", 0))
+		var serviceError *configurationaggregatorv1.ServiceError
+		Expect(errors.As(events[1].Err, &serviceError)).To(BeTrue())
+		Expect(serviceError.Message).To(Equal("Access denied"))
+	})
+	It(`Abort the requests rejected by a before-request hook`, func() {
+		rejected := errors.New("rejected")
+		var errorHooks []string
+		clone := configurationAggregatorService.Clone()
+		configurationAggregatorService.Use(
+			configurationaggregatorv1.Middleware{
+				OnError: func(event *configurationaggregatorv1.RequestEvent) {
+					errorHooks = append(errorHooks, "first")
+				},
+			},
+			configurationaggregatorv1.Middleware{
+				BeforeRequest: func(event *configurationaggregatorv1.RequestEvent) error {
+					return rejected
+				},
+				OnError: func(event *configurationaggregatorv1.RequestEvent) {
+					errorHooks = append(errorHooks, "second")
+				},
+			},
+			configurationaggregatorv1.Middleware{
+				OnError: func(event *configurationaggregatorv1.RequestEvent) {
+					errorHooks = append(errorHooks, "third")
+				},
+			})
+
+		_, response, err := configurationAggregatorService.ManualReconcile(configurationAggregatorService.NewManualReconcileOptions())
+		Expect(errors.Is(err, rejected)).To(BeTrue())
+		Expect(response).To(BeNil())
+		Expect(errorHooks).To(Equal([]string{"second", "first"}))
+
+		_, response, err = clone.ManualReconcile(clone.NewManualReconcileOptions())
+		Expect(errors.Is(err, configurationaggregatorv1.ErrNotFound)).To(BeTrue())
+		Expect(response.StatusCode).To(Equal(404))
+		Expect(errorHooks).To(HaveLen(2))
+	})
+})
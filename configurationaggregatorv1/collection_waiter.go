@@ -0,0 +1,375 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configurationaggregatorv1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	common "github.com/IBM/configuration-aggregator-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/go-openapi/strfmt"
+)
+
+// Default values of the WaitForCollectionCompleteOptions.
+const (
+	defaultWaitInterval      = 5 * time.Second
+	defaultWaitMaxInterval   = time.Minute
+	defaultWaitBackoffFactor = 1.5
+	defaultWaitTimeout       = 30 * time.Minute
+)
+
+// CollectionProgressCallback is invoked by WaitForCollectionComplete with the resource collection status
+// returned by each poll. The attempt number starts at 1.
+type CollectionProgressCallback func(attempt int64, status *StatusResponse)
+
+// WaitForCollectionCompleteOptions : The WaitForCollectionComplete options.
+type WaitForCollectionCompleteOptions struct {
+	// The delay between the first two polls. Defaults to 5 seconds, or to MaxInterval if it is less.
+	Interval *time.Duration
+
+	// The maximum delay between two polls. Defaults to 1 minute, or to Interval if it is more.
+	MaxInterval *time.Duration
+
+	// The factor applied to the delay after each poll. Defaults to 1.5; 1 polls at a fixed interval.
+	BackoffFactor *float64
+
+	// The maximum time to wait. Defaults to 30 minutes; 0 waits until the context is done.
+	Timeout *time.Duration
+
+	// The time the collection was triggered, e.g. the time ReplaceSettings or ManualReconcile was called.
+	// When set, the collection is only considered complete once the last configuration refresh time is after it.
+	TriggeredAt *strfmt.DateTime
+
+	// The callback invoked with the status returned by each poll.
+	ProgressCallback CollectionProgressCallback
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewWaitForCollectionCompleteOptions : Instantiate WaitForCollectionCompleteOptions
+func (*ConfigurationAggregatorV1) NewWaitForCollectionCompleteOptions() *WaitForCollectionCompleteOptions {
+	return &WaitForCollectionCompleteOptions{}
+}
+
+// SetInterval : Allow user to set Interval
+func (_options *WaitForCollectionCompleteOptions) SetInterval(interval time.Duration) *WaitForCollectionCompleteOptions {
+	_options.Interval = &interval
+	return _options
+}
+
+// SetMaxInterval : Allow user to set MaxInterval
+func (_options *WaitForCollectionCompleteOptions) SetMaxInterval(maxInterval time.Duration) *WaitForCollectionCompleteOptions {
+	_options.MaxInterval = &maxInterval
+	return _options
+}
+
+// SetBackoffFactor : Allow user to set BackoffFactor
+func (_options *WaitForCollectionCompleteOptions) SetBackoffFactor(backoffFactor float64) *WaitForCollectionCompleteOptions {
+	_options.BackoffFactor = core.Float64Ptr(backoffFactor)
+	return _options
+}
+
+// SetTimeout : Allow user to set Timeout
+func (_options *WaitForCollectionCompleteOptions) SetTimeout(timeout time.Duration) *WaitForCollectionCompleteOptions {
+	_options.Timeout = &timeout
+	return _options
+}
+
+// SetTriggeredAt : Allow user to set TriggeredAt
+func (_options *WaitForCollectionCompleteOptions) SetTriggeredAt(triggeredAt *strfmt.DateTime) *WaitForCollectionCompleteOptions {
+	_options.TriggeredAt = triggeredAt
+	return _options
+}
+
+// SetProgressCallback : Allow user to set ProgressCallback
+func (_options *WaitForCollectionCompleteOptions) SetProgressCallback(progressCallback CollectionProgressCallback) *WaitForCollectionCompleteOptions {
+	_options.ProgressCallback = progressCallback
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *WaitForCollectionCompleteOptions) SetHeaders(param map[string]string) *WaitForCollectionCompleteOptions {
+	options.Headers = param
+	return options
+}
+
+// CollectionTimeoutError is the cause of the error returned by WaitForCollectionComplete when the resource
+// collection does not complete within the timeout. Use errors.As() to retrieve it from the returned error.
+type CollectionTimeoutError struct {
+	// The timeout that expired.
+	Timeout time.Duration
+
+	// The number of polls made.
+	Attempts int64
+
+	// The status returned by the last successful poll, if any.
+	LastStatus *StatusResponse
+}
+
+// Error returns the message of the error.
+func (e *CollectionTimeoutError) Error() string {
+	status := "unknown"
+	if e.LastStatus != nil && e.LastStatus.Status != nil {
+		status = *e.LastStatus.Status
+	}
+	return fmt.Sprintf("the resource collection did not complete within %s after %d attempts (last status: %s)", e.Timeout, e.Attempts, status)
+}
+
+// Unwrap returns context.DeadlineExceeded, so that errors.Is(err, context.DeadlineExceeded) is true.
+func (e *CollectionTimeoutError) Unwrap() error {
+	return context.DeadlineExceeded
+}
+
+// WaitForCollectionComplete polls GetResourceCollectionStatus until the resource collection is complete, and returns
+// the last status. The delay between polls starts at the configured interval and is multiplied by the backoff
+// factor after each poll, up to the maximum interval. If TriggeredAt is set, a "complete" status is only accepted
+// once its LastConfigRefreshTime is after TriggeredAt, so that the completion of a previous collection is ignored.
+// If the timeout expires, the returned error is caused by a *CollectionTimeoutError.
+func (configurationAggregator *ConfigurationAggregatorV1) WaitForCollectionComplete(ctx context.Context, waitForCollectionCompleteOptions *WaitForCollectionCompleteOptions) (result *StatusResponse, err error) {
+	if waitForCollectionCompleteOptions == nil {
+		waitForCollectionCompleteOptions = new(WaitForCollectionCompleteOptions)
+	}
+	interval, maxInterval, backoffFactor, timeout, err := waitForCollectionCompleteOptions.resolve()
+	if err != nil {
+		return
+	}
+
+	waitCtx := ctx
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		waitCtx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	getStatusOptions := &GetResourceCollectionStatusOptions{
+		Headers: waitForCollectionCompleteOptions.Headers,
+	}
+	var attempts int64
+	for {
+		var status *StatusResponse
+		attempts++
+		status, _, err = configurationAggregator.GetResourceCollectionStatusWithContext(waitCtx, getStatusOptions)
+		if err != nil {
+			if waitCtx.Err() == nil {
+				err = core.RepurposeSDKProblem(err, "get-status-error")
+				return
+			}
+		} else {
+			result = status
+			if waitForCollectionCompleteOptions.ProgressCallback != nil {
+				waitForCollectionCompleteOptions.ProgressCallback(attempts, status)
+			}
+			if waitForCollectionCompleteOptions.isComplete(status) {
+				return
+			}
+
+			timer := time.NewTimer(interval)
+			select {
+			case <-waitCtx.Done():
+				timer.Stop()
+			case <-timer.C:
+			}
+			interval = time.Duration(float64(interval) * backoffFactor)
+			if interval > maxInterval {
+				interval = maxInterval
+			}
+		}
+
+		if waitCtx.Err() != nil {
+			if ctx.Err() != nil {
+				err = core.SDKErrorf(ctx.Err(), "", "context-done", common.GetComponentInfo())
+				return
+			}
+			timeoutErr := &CollectionTimeoutError{
+				Timeout:    timeout,
+				Attempts:   attempts,
+				LastStatus: result,
+			}
+			err = core.SDKErrorf(timeoutErr, "", "collection-timeout", common.GetComponentInfo())
+			return
+		}
+	}
+}
+
+// resolve returns the polling parameters, applying the defaults.
+func (_options *WaitForCollectionCompleteOptions) resolve() (interval, maxInterval time.Duration, backoffFactor float64, timeout time.Duration, err error) {
+	interval, maxInterval, backoffFactor, timeout = defaultWaitInterval, defaultWaitMaxInterval, defaultWaitBackoffFactor, defaultWaitTimeout
+	if _options.Interval != nil {
+		interval = *_options.Interval
+	}
+	if _options.MaxInterval != nil {
+		maxInterval = *_options.MaxInterval
+	}
+	// A default is adjusted to the value set by the caller, so that only values both set by the caller conflict.
+	switch {
+	case _options.MaxInterval == nil:
+		maxInterval = max(maxInterval, interval)
+	case _options.Interval == nil:
+		interval = min(interval, maxInterval)
+	}
+	if _options.BackoffFactor != nil {
+		backoffFactor = *_options.BackoffFactor
+	}
+	if _options.Timeout != nil {
+		timeout = *_options.Timeout
+	}
+
+	switch {
+	case interval <= 0:
+		err = fmt.Errorf("the interval must be positive: %s", interval)
+	case maxInterval < interval:
+		err = fmt.Errorf("the maximum interval (%s) must not be less than the interval (%s)", maxInterval, interval)
+	case backoffFactor < 1:
+		err = fmt.Errorf("the backoff factor must be at least 1: %g", backoffFactor)
+	case timeout < 0:
+		err = fmt.Errorf("the timeout must not be negative: %s", timeout)
+	}
+	if err != nil {
+		err = core.SDKErrorf(err, "", "invalid-wait-options", common.GetComponentInfo())
+	}
+	return
+}
+
+// isComplete returns true if the status denotes a completed collection that satisfies the options.
+func (_options *WaitForCollectionCompleteOptions) isComplete(status *StatusResponse) bool {
+	if status == nil || status.Status == nil || *status.Status != StatusResponse_Status_Complete {
+		return false
+	}
+	if _options.TriggeredAt == nil {
+		return true
+	}
+	return status.LastConfigRefreshTime != nil &&
+		time.Time(*status.LastConfigRefreshTime).After(time.Time(*_options.TriggeredAt))
+}
+
+// ManualReconcileAndWaitOptions : The ManualReconcileAndWait options.
+type ManualReconcileAndWaitOptions struct {
+	// The options used to wait for the collection. TriggeredAt is ignored: the collection is considered complete
+	// once its last configuration refresh time is after the one observed before triggering the reconciliation.
+	WaitOptions *WaitForCollectionCompleteOptions
+
+	// The filters of the resource configurations returned once the collection is complete.
+	// If not set, no configurations are listed.
+	ListConfigsOptions *ListConfigsOptions
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewManualReconcileAndWaitOptions : Instantiate ManualReconcileAndWaitOptions
+func (*ConfigurationAggregatorV1) NewManualReconcileAndWaitOptions() *ManualReconcileAndWaitOptions {
+	return &ManualReconcileAndWaitOptions{}
+}
+
+// SetWaitOptions : Allow user to set WaitOptions
+func (_options *ManualReconcileAndWaitOptions) SetWaitOptions(waitOptions *WaitForCollectionCompleteOptions) *ManualReconcileAndWaitOptions {
+	_options.WaitOptions = waitOptions
+	return _options
+}
+
+// SetListConfigsOptions : Allow user to set ListConfigsOptions
+func (_options *ManualReconcileAndWaitOptions) SetListConfigsOptions(listConfigsOptions *ListConfigsOptions) *ManualReconcileAndWaitOptions {
+	_options.ListConfigsOptions = listConfigsOptions
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *ManualReconcileAndWaitOptions) SetHeaders(param map[string]string) *ManualReconcileAndWaitOptions {
+	options.Headers = param
+	return options
+}
+
+// ManualReconcileAndWaitResult : The result of ManualReconcileAndWait.
+type ManualReconcileAndWaitResult struct {
+	// The status message of the manual reconciliation request.
+	Message *string
+
+	// The resource collection status before the reconciliation was triggered.
+	PreviousStatus *StatusResponse
+
+	// The resource collection status once the collection completed.
+	Status *StatusResponse
+
+	// The resource configurations collected, if ListConfigsOptions was set.
+	Configs []Config
+}
+
+// ManualReconcileAndWait triggers a manual reconciliation, waits until the resulting collection is complete, and
+// then lists the resource configurations that satisfy ListConfigsOptions, if set. The collection is considered
+// complete once its status is "complete" and its last configuration refresh time is after the one reported before
+// the reconciliation was triggered, or is set if none was reported. See WaitForCollectionComplete() for the polling and timeout behavior.
+func (configurationAggregator *ConfigurationAggregatorV1) ManualReconcileAndWait(ctx context.Context, manualReconcileAndWaitOptions *ManualReconcileAndWaitOptions) (result *ManualReconcileAndWaitResult, err error) {
+	if manualReconcileAndWaitOptions == nil {
+		manualReconcileAndWaitOptions = new(ManualReconcileAndWaitOptions)
+	}
+	headers := manualReconcileAndWaitOptions.Headers
+	result = new(ManualReconcileAndWaitResult)
+
+	result.PreviousStatus, _, err = configurationAggregator.GetResourceCollectionStatusWithContext(ctx, &GetResourceCollectionStatusOptions{Headers: headers})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "get-previous-status-error")
+		return nil, err
+	}
+
+	reconcileResponse, _, err := configurationAggregator.ManualReconcileWithContext(ctx, &ManualReconcileOptions{Headers: headers})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "manual-reconcile-error")
+		return nil, err
+	}
+	result.Message = reconcileResponse.Message
+
+	waitOptions := new(WaitForCollectionCompleteOptions)
+	if manualReconcileAndWaitOptions.WaitOptions != nil {
+		*waitOptions = *manualReconcileAndWaitOptions.WaitOptions
+	}
+	waitOptions.TriggeredAt = result.PreviousStatus.LastConfigRefreshTime
+	if waitOptions.TriggeredAt == nil {
+		// The instance has never been refreshed: the zero time makes the collection complete once a refresh time is
+		// reported, rather than as soon as a "complete" status is, which may predate the reconciliation.
+		waitOptions.TriggeredAt = new(strfmt.DateTime)
+	}
+	if waitOptions.Headers == nil {
+		waitOptions.Headers = headers
+	}
+	result.Status, err = configurationAggregator.WaitForCollectionComplete(ctx, waitOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "wait-for-collection-error")
+		return nil, err
+	}
+
+	if manualReconcileAndWaitOptions.ListConfigsOptions != nil {
+		listConfigsOptions := *manualReconcileAndWaitOptions.ListConfigsOptions
+		if listConfigsOptions.Headers == nil {
+			listConfigsOptions.Headers = headers
+		}
+		var pager *ConfigsPager
+		pager, err = configurationAggregator.NewConfigsPager(&listConfigsOptions)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "list-configs-error")
+			return nil, err
+		}
+		result.Configs, err = pager.GetAllWithContext(ctx)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "list-configs-error")
+			return nil, err
+		}
+	}
+	return
+}
@@ -0,0 +1,309 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configurationaggregatorv1_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"time"
+
+	"github.com/IBM/configuration-aggregator-go-sdk/configurationaggregatorv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ConfigurationAggregatorV1 collection waiter`, func() {
+	// newStatusServer returns a server that serves the specified status bodies in sequence, repeating the last one.
+	newStatusServer := func(requests *int64, bodies ...string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.URL.EscapedPath()).To(Equal("/resource_collection_status"))
+			Expect(req.Header["Test-Header"]).To(Equal([]string{"test"}))
+			n := atomic.AddInt64(requests, 1)
+			body := bodies[len(bodies)-1]
+			if int(n) <= len(bodies) {
+				body = bodies[n-1]
+			}
+			res.Header().Set("Content-type", "application/json")
+			if body == "" {
+				res.WriteHeader(500)
+				fmt.Fprint(res, `{"errors":[{"message":"server error"}]}`)
+				return
+			}
+			res.WriteHeader(200)
+			fmt.Fprint(res, body)
+		}))
+	}
+	newService := func(url string) *configurationaggregatorv1.ConfigurationAggregatorV1 {
+		configurationAggregatorService, serviceErr := configurationaggregatorv1.NewConfigurationAggregatorV1(&configurationaggregatorv1.ConfigurationAggregatorV1Options{
+			URL:           url,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		return configurationAggregatorService
+	}
+
+	It(`Invoke WaitForCollectionComplete successfully`, func() {
+		var requests int64
+		testServer := newStatusServer(&requests,
+			`{"status":"initiated"}`,
+			`{"status":"inprogress"}`,
+			`{"last_config_refresh_time":"2026-01-01T12:00:00.000Z","status":"complete"}`)
+		defer testServer.Close()
+		configurationAggregatorService := newService(testServer.URL)
+
+		var attempts []int64
+		options := configurationAggregatorService.NewWaitForCollectionCompleteOptions().
+			SetInterval(time.Millisecond).
+			SetMaxInterval(2 * time.Millisecond).
+			SetBackoffFactor(2).
+			SetTimeout(10 * time.Second).
+			SetProgressCallback(func(attempt int64, status *configurationaggregatorv1.StatusResponse) {
+				attempts = append(attempts, attempt)
+			}).
+			SetHeaders(map[string]string{"Test-Header": "test"})
+		result, err := configurationAggregatorService.WaitForCollectionComplete(context.Background(), options)
+		Expect(err).To(BeNil())
+		Expect(*result.Status).To(Equal(configurationaggregatorv1.StatusResponse_Status_Complete))
+		Expect(attempts).To(Equal([]int64{1, 2, 3}))
+		Expect(requests).To(Equal(int64(3)))
+	})
+	It(`Wait until the last refresh time advances past the trigger time`, func() {
+		var requests int64
+		testServer := newStatusServer(&requests,
+			`{"last_config_refresh_time":"2026-01-01T12:00:00.000Z","status":"complete"}`,
+			`{"last_config_refresh_time":"2026-01-01T12:00:00.000Z","status":"inprogress"}`,
+			`{"last_config_refresh_time":"2026-01-01T12:05:00.000Z","status":"complete"}`)
+		defer testServer.Close()
+		configurationAggregatorService := newService(testServer.URL)
+
+		options := configurationAggregatorService.NewWaitForCollectionCompleteOptions().
+			SetInterval(time.Millisecond).
+			SetTriggeredAt(CreateMockDateTime("2026-01-01T12:01:00.000Z")).
+			SetHeaders(map[string]string{"Test-Header": "test"})
+		result, err := configurationAggregatorService.WaitForCollectionComplete(context.Background(), options)
+		Expect(err).To(BeNil())
+		Expect(result.LastConfigRefreshTime).To(Equal(CreateMockDateTime("2026-01-01T12:05:00.000Z")))
+		Expect(requests).To(Equal(int64(3)))
+	})
+	It(`Invoke WaitForCollectionComplete with timeout`, func() {
+		var requests int64
+		testServer := newStatusServer(&requests, `{"status":"inprogress"}`)
+		defer testServer.Close()
+		configurationAggregatorService := newService(testServer.URL)
+
+		options := configurationAggregatorService.NewWaitForCollectionCompleteOptions().
+			SetInterval(5 * time.Millisecond).
+			SetTimeout(50 * time.Millisecond).
+			SetHeaders(map[string]string{"Test-Header": "test"})
+		result, err := configurationAggregatorService.WaitForCollectionComplete(context.Background(), options)
+		Expect(err).ToNot(BeNil())
+		Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+		var timeoutErr *configurationaggregatorv1.CollectionTimeoutError
+		Expect(errors.As(err, &timeoutErr)).To(BeTrue())
+		Expect(timeoutErr.Timeout).To(Equal(50 * time.Millisecond))
+		Expect(timeoutErr.Attempts).To(BeNumerically(">", 1))
+		Expect(*timeoutErr.LastStatus.Status).To(Equal("inprogress"))
+		Expect(*result.Status).To(Equal("inprogress"))
+	})
+	It(`Invoke WaitForCollectionComplete with a cancelled context`, func() {
+		var requests int64
+		testServer := newStatusServer(&requests, `{"status":"inprogress"}`)
+		defer testServer.Close()
+		configurationAggregatorService := newService(testServer.URL)
+
+		ctx, cancelFunc := context.WithCancel(context.Background())
+		options := configurationAggregatorService.NewWaitForCollectionCompleteOptions().
+			SetInterval(time.Millisecond).
+			SetProgressCallback(func(attempt int64, status *configurationaggregatorv1.StatusResponse) {
+				if attempt == 2 {
+					cancelFunc()
+				}
+			}).
+			SetHeaders(map[string]string{"Test-Header": "test"})
+		_, err := configurationAggregatorService.WaitForCollectionComplete(ctx, options)
+		Expect(err).ToNot(BeNil())
+		Expect(errors.Is(err, context.Canceled)).To(BeTrue())
+		var timeoutErr *configurationaggregatorv1.CollectionTimeoutError
+		Expect(errors.As(err, &timeoutErr)).To(BeFalse())
+	})
+	It(`Adjust the default intervals to the interval set`, func() {
+		var requests int64
+		testServer := newStatusServer(&requests, `{"status":"initiated"}`, `{"last_config_refresh_time":"2026-01-01T12:00:00.000Z","status":"complete"}`)
+		defer testServer.Close()
+		configurationAggregatorService := newService(testServer.URL)
+
+		options := configurationAggregatorService.NewWaitForCollectionCompleteOptions().
+			SetMaxInterval(time.Millisecond).
+			SetHeaders(map[string]string{"Test-Header": "test"})
+		status, err := configurationAggregatorService.WaitForCollectionComplete(context.Background(), options)
+		Expect(err).To(BeNil())
+		Expect(*status.Status).To(Equal("complete"))
+		Expect(requests).To(Equal(int64(2)))
+
+		options = configurationAggregatorService.NewWaitForCollectionCompleteOptions().
+			SetInterval(2 * time.Minute).
+			SetHeaders(map[string]string{"Test-Header": "test"})
+		status, err = configurationAggregatorService.WaitForCollectionComplete(context.Background(), options)
+		Expect(err).To(BeNil())
+		Expect(*status.Status).To(Equal("complete"))
+		Expect(requests).To(Equal(int64(3)))
+	})
+	It(`Invoke WaitForCollectionComplete with error`, func() {
+		var requests int64
+		testServer := newStatusServer(&requests, `{"status":"initiated"}`, "")
+		defer testServer.Close()
+		configurationAggregatorService := newService(testServer.URL)
+
+		options := configurationAggregatorService.NewWaitForCollectionCompleteOptions().
+			SetInterval(time.Millisecond).
+			SetHeaders(map[string]string{"Test-Header": "test"})
+		_, err := configurationAggregatorService.WaitForCollectionComplete(context.Background(), options)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("server error"))
+
+		for _, invalidOptions := range []*configurationaggregatorv1.WaitForCollectionCompleteOptions{
+			configurationAggregatorService.NewWaitForCollectionCompleteOptions().SetInterval(0),
+			configurationAggregatorService.NewWaitForCollectionCompleteOptions().SetInterval(time.Hour).SetMaxInterval(time.Minute),
+			configurationAggregatorService.NewWaitForCollectionCompleteOptions().SetBackoffFactor(0.5),
+			configurationAggregatorService.NewWaitForCollectionCompleteOptions().SetTimeout(-time.Second),
+		} {
+			_, err = configurationAggregatorService.WaitForCollectionComplete(context.Background(), invalidOptions)
+			Expect(err).ToNot(BeNil())
+		}
+		Expect(requests).To(Equal(int64(2)))
+	})
+	It(`Invoke ManualReconcileAndWait successfully`, func() {
+		var statusRequests int64
+		var reconcileRequests int64
+		testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.Header["Test-Header"]).To(Equal([]string{"test"}))
+			res.Header().Set("Content-type", "application/json")
+			switch req.URL.EscapedPath() {
+			case "/resource_collection_status":
+				Expect(req.Method).To(Equal("GET"))
+				switch atomic.AddInt64(&statusRequests, 1) {
+				case 1:
+					Expect(atomic.LoadInt64(&reconcileRequests)).To(Equal(int64(0)))
+					fmt.Fprint(res, `{"last_config_refresh_time":"2026-01-01T12:00:00.000Z","status":"complete"}`)
+				case 2:
+					fmt.Fprint(res, `{"last_config_refresh_time":"2026-01-01T12:00:00.000Z","status":"complete"}`)
+				case 3:
+					fmt.Fprint(res, `{"last_config_refresh_time":"2026-01-01T12:00:00.000Z","status":"inprogress"}`)
+				default:
+					fmt.Fprint(res, `{"last_config_refresh_time":"2026-01-01T12:10:00.000Z","status":"complete"}`)
+				}
+			case "/reconcile":
+				Expect(req.Method).To(Equal("POST"))
+				atomic.AddInt64(&reconcileRequests, 1)
+				res.WriteHeader(202)
+				fmt.Fprint(res, `{"message":"Manual reconciliation is initiated"}`)
+			case "/configs":
+				Expect(atomic.LoadInt64(&statusRequests)).To(Equal(int64(4)))
+				Expect(req.URL.Query()["service_name"]).To(Equal([]string{"kms"}))
+				fmt.Fprintf(res, `{"configs":[%s]}`, mockConfigJSON("crn1"))
+			default:
+				Fail("unexpected request: " + req.URL.EscapedPath())
+			}
+		}))
+		defer testServer.Close()
+		configurationAggregatorService := newService(testServer.URL)
+
+		waitOptions := configurationAggregatorService.NewWaitForCollectionCompleteOptions().
+			SetInterval(time.Millisecond).
+			SetTriggeredAt(CreateMockDateTime("2030-01-01T00:00:00.000Z"))
+		options := configurationAggregatorService.NewManualReconcileAndWaitOptions().
+			SetWaitOptions(waitOptions).
+			SetListConfigsOptions(configurationAggregatorService.NewListConfigsOptions().SetServiceName("kms")).
+			SetHeaders(map[string]string{"Test-Header": "test"})
+		result, err := configurationAggregatorService.ManualReconcileAndWait(context.Background(), options)
+		Expect(err).To(BeNil())
+		Expect(*result.Message).To(Equal("Manual reconciliation is initiated"))
+		Expect(result.PreviousStatus.LastConfigRefreshTime).To(Equal(CreateMockDateTime("2026-01-01T12:00:00.000Z")))
+		Expect(result.Status.LastConfigRefreshTime).To(Equal(CreateMockDateTime("2026-01-01T12:10:00.000Z")))
+		Expect(result.Configs).To(HaveLen(1))
+		Expect(*result.Configs[0].About.ResourceCrn).To(Equal("crn1"))
+		Expect(reconcileRequests).To(Equal(int64(1)))
+
+		// The options of the caller are not modified.
+		Expect(waitOptions.TriggeredAt).To(Equal(CreateMockDateTime("2030-01-01T00:00:00.000Z")))
+		Expect(waitOptions.Headers).To(BeNil())
+	})
+	It(`Invoke ManualReconcileAndWait on an instance that has never been refreshed`, func() {
+		var statusRequests int64
+		testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			switch req.URL.EscapedPath() {
+			case "/resource_collection_status":
+				switch atomic.AddInt64(&statusRequests, 1) {
+				case 1, 2:
+					fmt.Fprint(res, `{"status":"complete"}`)
+				case 3:
+					fmt.Fprint(res, `{"status":"inprogress"}`)
+				default:
+					fmt.Fprint(res, `{"last_config_refresh_time":"2026-01-01T12:10:00.000Z","status":"complete"}`)
+				}
+			case "/reconcile":
+				res.WriteHeader(202)
+				fmt.Fprint(res, `{"message":"Manual reconciliation is initiated"}`)
+			default:
+				Fail("unexpected request: " + req.URL.EscapedPath())
+			}
+		}))
+		defer testServer.Close()
+		configurationAggregatorService := newService(testServer.URL)
+
+		options := configurationAggregatorService.NewManualReconcileAndWaitOptions().
+			SetWaitOptions(configurationAggregatorService.NewWaitForCollectionCompleteOptions().SetInterval(time.Millisecond))
+		result, err := configurationAggregatorService.ManualReconcileAndWait(context.Background(), options)
+		Expect(err).To(BeNil())
+		Expect(result.PreviousStatus.LastConfigRefreshTime).To(BeNil())
+		Expect(result.Status.LastConfigRefreshTime).To(Equal(CreateMockDateTime("2026-01-01T12:10:00.000Z")))
+		Expect(statusRequests).To(Equal(int64(4)))
+	})
+	It(`Invoke ManualReconcileAndWait with error`, func() {
+		testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			switch req.URL.EscapedPath() {
+			case "/resource_collection_status":
+				fmt.Fprint(res, `{"status":"complete"}`)
+			default:
+				res.WriteHeader(429)
+				fmt.Fprint(res, `{"errors":[{"message":"too many requests"}]}`)
+			}
+		}))
+		defer testServer.Close()
+		configurationAggregatorService := newService(testServer.URL)
+
+		result, err := configurationAggregatorService.ManualReconcileAndWait(context.Background(), nil)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("too many requests"))
+		Expect(result).To(BeNil())
+	})
+})
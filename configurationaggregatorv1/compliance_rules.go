@@ -0,0 +1,492 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configurationaggregatorv1
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	common "github.com/IBM/configuration-aggregator-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"sigs.k8s.io/yaml"
+)
+
+// Constants associated with the Rule.Severity property, from the most to the least severe.
+const (
+	Rule_Severity_Critical = "critical"
+	Rule_Severity_High     = "high"
+	Rule_Severity_Medium   = "medium"
+	Rule_Severity_Low      = "low"
+	Rule_Severity_Info     = "info"
+)
+
+// ruleSeverities lists the severities from the most to the least severe.
+var ruleSeverities = []string{
+	Rule_Severity_Critical,
+	Rule_Severity_High,
+	Rule_Severity_Medium,
+	Rule_Severity_Low,
+	Rule_Severity_Info,
+}
+
+// Constants associated with the Finding.Status property.
+const (
+	Finding_Status_Error = "error"
+	Finding_Status_Fail  = "fail"
+	Finding_Status_Pass  = "pass"
+)
+
+// Rule : A compliance rule. A rule applies to the resources selected by its target, and a resource complies with
+// the rule if all of its assertions are true. In a rules file, a rule looks like this:
+//
+//	rules:
+//	  - id: kms-rotation-enabled
+//	    description: Root keys must be rotated at least every 3 months.
+//	    severity: high
+//	    target:
+//	      service_name: kms
+//	      where: config.extractable == false
+//	    assertions:
+//	      - expression: config.rotation.enabled == true
+//	        message: automatic rotation is disabled
+//	      - expression: config.rotation.interval_month <= 3
+//	    remediation: Enable automatic rotation with an interval of 3 months or less.
+//
+// Rules are evaluated through a RuleSet, which compiles their expressions; see NewRuleSet.
+type Rule struct {
+	// The unique ID of the rule.
+	ID string `json:"id"`
+
+	// The description of the rule.
+	Description string `json:"description,omitempty"`
+
+	// The severity of a violation of the rule. See the Rule_Severity_* constants.
+	Severity string `json:"severity"`
+
+	// The resources the rule applies to. A rule without target applies to every resource.
+	Target RuleTarget `json:"target"`
+
+	// The assertions a resource must satisfy to comply with the rule.
+	Assertions []RuleAssertion `json:"assertions"`
+
+	// The steps to bring a resource that violates the rule into compliance.
+	Remediation string `json:"remediation,omitempty"`
+
+	// The location of the rule in the rules files, used in error messages, and its compiled queries.
+	source     string
+	where      *ConfigQuery
+	assertions []*ConfigQuery
+}
+
+// RuleTarget : The resources a rule applies to. A resource is targeted if it matches all the properties that are set.
+type RuleTarget struct {
+	// The name of the service of the resources, e.g. "kms".
+	ServiceName string `json:"service_name,omitempty"`
+
+	// The type of configuration of the resources.
+	ConfigType string `json:"config_type,omitempty"`
+
+	// A ConfigQuery expression the resources must match, for finer selections.
+	Where string `json:"where,omitempty"`
+}
+
+// RuleAssertion : An assertion over the properties of a resource configuration.
+type RuleAssertion struct {
+	// A ConfigQuery expression that must be true, e.g. config.encryption.enabled == true.
+	Expression string `json:"expression"`
+
+	// The message reported when the assertion is false. Defaults to the expression.
+	Message string `json:"message,omitempty"`
+}
+
+// ruleFile is the document of a rules file.
+type ruleFile struct {
+	Rules []Rule `json:"rules"`
+}
+
+// RuleSet : A set of compiled compliance rules. A RuleSet is safe for concurrent use.
+type RuleSet struct {
+	rules []Rule
+}
+
+// NewRuleSet validates the rules and compiles their expressions. Each rule must have a unique ID, a valid severity
+// and at least one assertion, and its expressions must compile. All the violations are reported at once by a
+// *ValidationError.
+func NewRuleSet(rules []Rule) (ruleSet *RuleSet, err error) {
+	ruleSet = &RuleSet{rules: make([]Rule, len(rules))}
+	var violations []FieldViolation
+	ids := make(map[string]string)
+
+	for i, rule := range rules {
+		if rule.source == "" {
+			rule.source = fmt.Sprintf("rules[%d]", i)
+		}
+		field := func(name string) string {
+			return rule.source + "." + name
+		}
+
+		switch previous, duplicate := ids[rule.ID]; {
+		case rule.ID == "":
+			violations = append(violations, FieldViolation{Field: field("id"), Message: "the ID must be specified"})
+		case duplicate:
+			violations = append(violations, FieldViolation{Field: field("id"), Message: fmt.Sprintf("the ID '%s' is already used by %s", rule.ID, previous)})
+		default:
+			ids[rule.ID] = rule.source
+		}
+
+		if !containsString(ruleSeverities, rule.Severity) {
+			violations = append(violations, FieldViolation{
+				Field:   field("severity"),
+				Message: fmt.Sprintf("'%s' is not a valid severity, valid severities are: %s", rule.Severity, strings.Join(ruleSeverities, ", ")),
+			})
+		}
+
+		rule.where = nil
+		if rule.Target.Where != "" {
+			query, compileErr := CompileConfigQuery(rule.Target.Where)
+			if compileErr != nil {
+				violations = append(violations, FieldViolation{Field: field("target.where"), Message: compileErr.Error()})
+			}
+			rule.where = query
+		}
+
+		if len(rule.Assertions) == 0 {
+			violations = append(violations, FieldViolation{Field: field("assertions"), Message: "at least one assertion must be specified"})
+		}
+		rule.assertions = make([]*ConfigQuery, len(rule.Assertions))
+		for j, assertion := range rule.Assertions {
+			query, compileErr := CompileConfigQuery(assertion.Expression)
+			if compileErr != nil {
+				violations = append(violations, FieldViolation{Field: field(fmt.Sprintf("assertions[%d].expression", j)), Message: compileErr.Error()})
+			}
+			rule.assertions[j] = query
+		}
+		ruleSet.rules[i] = rule
+	}
+
+	err = newValidationError(violations)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ParseRuleSet parses a rules document in YAML or JSON, with a top-level "rules" list, and returns the compiled
+// rules. Unknown properties are rejected, to catch misspelled ones. See Rule for the format of the rules.
+func ParseRuleSet(data []byte) (ruleSet *RuleSet, err error) {
+	rules, err := parseRules(data, "")
+	if err != nil {
+		return
+	}
+	ruleSet, err = NewRuleSet(rules)
+	return
+}
+
+// LoadRuleSet loads the rules of the specified files and returns the compiled rules. A path that is a directory
+// stands for the .yaml, .yml and .json files it contains. Rule IDs must be unique across all the files.
+func LoadRuleSet(paths ...string) (ruleSet *RuleSet, err error) {
+	var rules []Rule
+	for _, path := range paths {
+		var files []string
+		files, err = ruleFiles(path)
+		if err != nil {
+			return
+		}
+		for _, file := range files {
+			var data []byte
+			data, err = os.ReadFile(file)
+			if err != nil {
+				err = core.SDKErrorf(err, "", "read-rules-error", common.GetComponentInfo())
+				return
+			}
+			var fileRules []Rule
+			fileRules, err = parseRules(data, file)
+			if err != nil {
+				return
+			}
+			rules = append(rules, fileRules...)
+		}
+	}
+	ruleSet, err = NewRuleSet(rules)
+	return
+}
+
+func ruleFiles(path string) (files []string, err error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "read-rules-error", common.GetComponentInfo())
+		return
+	}
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "read-rules-error", common.GetComponentInfo())
+		return
+	}
+	for _, entry := range entries {
+		switch strings.ToLower(filepath.Ext(entry.Name())) {
+		case ".yaml", ".yml", ".json":
+			if !entry.IsDir() {
+				files = append(files, filepath.Join(path, entry.Name()))
+			}
+		}
+	}
+	return
+}
+
+// parseRules parses a rules document. The rules are labeled with their location for error messages.
+func parseRules(data []byte, file string) (rules []Rule, err error) {
+	document := new(ruleFile)
+	err = yaml.UnmarshalStrict(data, document)
+	if err != nil {
+		message := fmt.Sprintf("invalid rules document: %s", err.Error())
+		if file != "" {
+			message = fmt.Sprintf("invalid rules file '%s': %s", file, err.Error())
+		}
+		err = core.SDKErrorf(err, message, "invalid-rules", common.GetComponentInfo())
+		return
+	}
+	rules = document.Rules
+	for i := range rules {
+		rules[i].source = fmt.Sprintf("rules[%d]", i)
+		if file != "" {
+			rules[i].source = fmt.Sprintf("%s: rules[%d]", file, i)
+		}
+	}
+	return
+}
+
+// Rules returns the rules of the set.
+func (ruleSet *RuleSet) Rules() []Rule {
+	return append([]Rule(nil), ruleSet.rules...)
+}
+
+// targets returns true if the rule applies to the resource configuration. The rule must have been compiled by
+// NewRuleSet.
+func (rule *Rule) targets(config *Config) bool {
+	about := config.About
+	if about == nil {
+		about = new(About)
+	}
+	if rule.Target.ServiceName != "" && core.StringNilMapper(about.ServiceName) != rule.Target.ServiceName {
+		return false
+	}
+	if rule.Target.ConfigType != "" && core.StringNilMapper(about.ConfigType) != rule.Target.ConfigType {
+		return false
+	}
+	return rule.where == nil || rule.where.Matches(config)
+}
+
+// Finding : The result of the evaluation of a rule against a resource.
+type Finding struct {
+	// The ID of the rule.
+	RuleID string `json:"rule_id"`
+
+	// The severity of the rule. See the Rule_Severity_* constants.
+	Severity string `json:"severity"`
+
+	// Whether the resource complies with the rule. See the Finding_Status_* constants. The status is "error" if
+	// an assertion could not be evaluated, e.g. because it compares values of different types, and none was false.
+	Status string `json:"status"`
+
+	// The CRN of the resource.
+	ResourceCrn string `json:"resource_crn"`
+
+	// The name of the resource.
+	ResourceName string `json:"resource_name,omitempty"`
+
+	// The name of the service of the resource.
+	ServiceName string `json:"service_name,omitempty"`
+
+	// The messages of the assertions that are false or could not be evaluated, separated by "; ".
+	Message string `json:"message,omitempty"`
+
+	// The remediation of the rule, for the resources that do not comply with it.
+	Remediation string `json:"remediation,omitempty"`
+}
+
+// evaluate evaluates the rule against a resource configuration. All the assertions are evaluated, so that the
+// finding reports every violation. The second return value is false if the rule does not apply to the resource.
+// The rule must have been compiled by NewRuleSet, so rules are only evaluated through a RuleSet.
+func (rule *Rule) evaluate(config *Config) (finding Finding, targeted bool) {
+	if !rule.targets(config) {
+		return
+	}
+	about := config.About
+	if about == nil {
+		about = new(About)
+	}
+	finding = Finding{
+		RuleID:       rule.ID,
+		Severity:     rule.Severity,
+		Status:       Finding_Status_Pass,
+		ResourceCrn:  core.StringNilMapper(about.ResourceCrn),
+		ResourceName: core.StringNilMapper(about.ResourceName),
+		ServiceName:  core.StringNilMapper(about.ServiceName),
+	}
+
+	var failures, evalErrors []string
+	for i, query := range rule.assertions {
+		result, err := query.Evaluate(config)
+		switch {
+		case err != nil:
+			evalErrors = append(evalErrors, fmt.Sprintf("%s: %s", rule.Assertions[i].Expression, err.Error()))
+		case !result:
+			message := rule.Assertions[i].Message
+			if message == "" {
+				message = fmt.Sprintf("assertion failed: %s", rule.Assertions[i].Expression)
+			}
+			failures = append(failures, message)
+		}
+	}
+	switch {
+	case len(failures) > 0:
+		finding.Status = Finding_Status_Fail
+	case len(evalErrors) > 0:
+		finding.Status = Finding_Status_Error
+	default:
+		return finding, true
+	}
+	finding.Message = strings.Join(append(failures, evalErrors...), "; ")
+	finding.Remediation = rule.Remediation
+	return finding, true
+}
+
+// EvaluateConfig evaluates the rules against a resource configuration, and returns a finding for each rule that
+// applies to the resource, in the order of the rules.
+func (ruleSet *RuleSet) EvaluateConfig(config *Config) (findings []Finding) {
+	for i := range ruleSet.rules {
+		if finding, targeted := ruleSet.rules[i].evaluate(config); targeted {
+			findings = append(findings, finding)
+		}
+	}
+	return
+}
+
+// Evaluate evaluates the rules against the resource configurations, e.g. the result set of ListConfigs or
+// the resources of a Snapshot.
+func (ruleSet *RuleSet) Evaluate(configs []Config) *ComplianceReport {
+	report := newComplianceReport()
+	for i := range configs {
+		report.add(ruleSet.EvaluateConfig(&configs[i]))
+	}
+	return report
+}
+
+// EvaluateSeq evaluates the rules against the resource configurations of a sequence, e.g. the iterator returned by
+// AllConfigs(), without holding them in memory. The evaluation stops at the first error of the sequence.
+func (ruleSet *RuleSet) EvaluateSeq(seq iter.Seq2[Config, error]) (report *ComplianceReport, err error) {
+	report = newComplianceReport()
+	for config, seqErr := range seq {
+		if seqErr != nil {
+			return nil, core.RepurposeSDKProblem(seqErr, "evaluate-rules-error")
+		}
+		report.add(ruleSet.EvaluateConfig(&config))
+	}
+	return
+}
+
+// EvaluateRules evaluates the rules against all the resource configurations matching the specified options,
+// retrieving them page by page.
+func (configurationAggregator *ConfigurationAggregatorV1) EvaluateRules(ctx context.Context, ruleSet *RuleSet, listConfigsOptions *ListConfigsOptions) (report *ComplianceReport, err error) {
+	err = core.ValidateNotNil(ruleSet, "ruleSet cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	return ruleSet.EvaluateSeq(configurationAggregator.AllConfigs(ctx, listConfigsOptions))
+}
+
+// ComplianceReport : The findings of the evaluation of a RuleSet.
+type ComplianceReport struct {
+	// The findings, by resource in the order they were evaluated, then by rule.
+	Findings []Finding `json:"findings"`
+
+	// The number of resources that were evaluated, whether or not a rule applied to them.
+	Resources int64 `json:"resources"`
+
+	// The number of findings by status.
+	Passed int64 `json:"passed"`
+	Failed int64 `json:"failed"`
+	Errors int64 `json:"errors"`
+
+	// The number of failed findings by severity.
+	FailedBySeverity map[string]int64 `json:"failed_by_severity"`
+}
+
+func newComplianceReport() *ComplianceReport {
+	return &ComplianceReport{
+		Findings:         []Finding{},
+		FailedBySeverity: make(map[string]int64),
+	}
+}
+
+func (report *ComplianceReport) add(findings []Finding) {
+	report.Resources++
+	for _, finding := range findings {
+		switch finding.Status {
+		case Finding_Status_Pass:
+			report.Passed++
+		case Finding_Status_Fail:
+			report.Failed++
+			report.FailedBySeverity[finding.Severity]++
+		case Finding_Status_Error:
+			report.Errors++
+		}
+	}
+	report.Findings = append(report.Findings, findings...)
+}
+
+// Failures returns the failed findings whose severity is at least the specified severity, sorted by decreasing
+// severity. An empty severity selects all the failed findings.
+func (report *ComplianceReport) Failures(minSeverity string) (failures []Finding) {
+	limit := len(ruleSeverities)
+	if minSeverity != "" {
+		limit = severityRank(minSeverity) + 1
+	}
+	for _, finding := range report.Findings {
+		if finding.Status == Finding_Status_Fail && severityRank(finding.Severity) < limit {
+			failures = append(failures, finding)
+		}
+	}
+	sort.SliceStable(failures, func(i, j int) bool {
+		return severityRank(failures[i].Severity) < severityRank(failures[j].Severity)
+	})
+	return
+}
+
+// severityRank returns the rank of a severity, 0 being the most severe.
+func severityRank(severity string) int {
+	for i, s := range ruleSeverities {
+		if s == severity {
+			return i
+		}
+	}
+	return len(ruleSeverities)
+}
+
+// Summary returns a one-line summary of the report, e.g. "12 resources, 30 passed, 2 failed, 0 errors".
+func (report *ComplianceReport) Summary() string {
+	return fmt.Sprintf("%d resources, %d passed, %d failed, %d errors", report.Resources, report.Passed, report.Failed, report.Errors)
+}
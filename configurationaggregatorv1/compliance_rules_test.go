@@ -0,0 +1,173 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configurationaggregatorv1_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	"github.com/IBM/configuration-aggregator-go-sdk/configurationaggregatorv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+const mockRulesYAML = `
+rules:
+  - id: kms-rotation-enabled
+    description: Keys must be rotated at least every 3 months.
+    severity: high
+    target:
+      service_name: kms
+    assertions:
+      - expression: config.rotation.enabled == true
+        message: automatic rotation is disabled
+      - expression: config.rotation.interval_month <= 3
+    remediation: Enable automatic rotation.
+  - id: prod-tagged
+    severity: low
+    target:
+      where: location == "us-south"
+    assertions:
+      - expression: '"env:prod" in user_tags'
+`
+
+const mockRulesJSON = `{
+  "rules": [
+    {
+      "id": "cos-versioning",
+      "severity": "medium",
+      "target": {"service_name": "cloud-object-storage", "config_type": "ConfigType"},
+      "assertions": [{"expression": "config.versioning.enabled"}],
+      "remediation": "Enable versioning."
+    }
+  ]
+}`
+
+var _ = Describe(`ConfigurationAggregatorV1 compliance rules`, func() {
+	var configs []configurationaggregatorv1.Config
+
+	BeforeEach(func() {
+		rotated := mockConfig("crn:key1", "kms", "us-south", []string{"env:prod"})
+		rotated.Config.SetProperty("rotation", map[string]interface{}{"enabled": true, "interval_month": float64(3)})
+		notRotated := mockConfig("crn:key2", "kms", "eu-de", nil)
+		notRotated.Config.SetProperty("rotation", map[string]interface{}{"enabled": false, "interval_month": float64(6)})
+		bucket := mockConfig("crn:bucket1", "cloud-object-storage", "us-south", nil)
+		bucket.Config.SetProperty("versioning", map[string]interface{}{"enabled": "yes"})
+		configs = []configurationaggregatorv1.Config{rotated, notRotated, bucket}
+	})
+
+	It(`Evaluate rules from YAML and JSON successfully`, func() {
+		dir, err := os.MkdirTemp("", "rules")
+		Expect(err).To(BeNil())
+		defer os.RemoveAll(dir)
+		Expect(os.WriteFile(filepath.Join(dir, "kms.yaml"), []byte(mockRulesYAML), 0o600)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(dir, "cos.json"), []byte(mockRulesJSON), 0o600)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(dir, "README.md"), []byte("not a rules file"), 0o600)).To(Succeed())
+
+		ruleSet, err := configurationaggregatorv1.LoadRuleSet(dir)
+		Expect(err).To(BeNil())
+		Expect(ruleSet.Rules()).To(HaveLen(3))
+
+		report := ruleSet.Evaluate(configs)
+		Expect(report.Resources).To(Equal(int64(3)))
+		Expect(report.Summary()).To(Equal("3 resources, 2 passed, 2 failed, 1 errors"))
+		Expect(report.FailedBySeverity).To(Equal(map[string]int64{"high": 1, "low": 1}))
+
+		findings := map[string]configurationaggregatorv1.Finding{}
+		for _, finding := range report.Findings {
+			findings[finding.RuleID+" "+finding.ResourceCrn] = finding
+		}
+		Expect(findings).To(HaveLen(5))
+		Expect(findings["kms-rotation-enabled crn:key1"].Status).To(Equal(configurationaggregatorv1.Finding_Status_Pass))
+		Expect(findings["kms-rotation-enabled crn:key1"].Remediation).To(BeEmpty())
+		Expect(findings["kms-rotation-enabled crn:key2"]).To(Equal(configurationaggregatorv1.Finding{
+			RuleID:       "kms-rotation-enabled",
+			Severity:     configurationaggregatorv1.Rule_Severity_High,
+			Status:       configurationaggregatorv1.Finding_Status_Fail,
+			ResourceCrn:  "crn:key2",
+			ResourceName: "crn:key2-name",
+			ServiceName:  "kms",
+			Message:      "automatic rotation is disabled; assertion failed: config.rotation.interval_month <= 3",
+			Remediation:  "Enable automatic rotation.",
+		}))
+		Expect(findings["prod-tagged crn:key1"].Status).To(Equal(configurationaggregatorv1.Finding_Status_Pass))
+		Expect(findings["prod-tagged crn:bucket1"].Status).To(Equal(configurationaggregatorv1.Finding_Status_Fail))
+		Expect(findings["cos-versioning crn:bucket1"].Status).To(Equal(configurationaggregatorv1.Finding_Status_Error))
+		Expect(findings["cos-versioning crn:bucket1"].Message).To(HavePrefix("config.versioning.enabled: "))
+
+		failures := report.Failures(configurationaggregatorv1.Rule_Severity_Medium)
+		Expect(failures).To(HaveLen(1))
+		Expect(failures[0].RuleID).To(Equal("kms-rotation-enabled"))
+		Expect(report.Failures("")).To(HaveLen(2))
+	})
+	It(`Evaluate rules over a sequence successfully`, func() {
+		ruleSet, err := configurationaggregatorv1.ParseRuleSet([]byte(mockRulesYAML))
+		Expect(err).To(BeNil())
+
+		report, err := ruleSet.EvaluateSeq(func(yield func(configurationaggregatorv1.Config, error) bool) {
+			for _, config := range configs {
+				if !yield(config, nil) {
+					return
+				}
+			}
+		})
+		Expect(err).To(BeNil())
+		Expect(report.Resources).To(Equal(int64(3)))
+		Expect(report.Failed).To(Equal(int64(2)))
+
+		_, err = ruleSet.EvaluateSeq(func(yield func(configurationaggregatorv1.Config, error) bool) {
+			yield(configurationaggregatorv1.Config{}, errors.New("page error"))
+		})
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("page error"))
+	})
+	It(`Reject invalid rules`, func() {
+		_, err := configurationaggregatorv1.ParseRuleSet([]byte(`
+rules:
+  - id: a
+    severity: urgent
+    assertions:
+      - expression: config.x ==
+  - id: a
+    severity: low
+    target:
+      where: "("
+`))
+		Expect(err).ToNot(BeNil())
+		var validationErr *configurationaggregatorv1.ValidationError
+		Expect(errors.As(err, &validationErr)).To(BeTrue())
+		var fields []string
+		for _, violation := range validationErr.Violations {
+			fields = append(fields, violation.Field)
+		}
+		Expect(fields).To(Equal([]string{
+			"rules[0].severity",
+			"rules[0].assertions[0].expression",
+			"rules[1].id",
+			"rules[1].target.where",
+			"rules[1].assertions",
+		}))
+
+		_, err = configurationaggregatorv1.ParseRuleSet([]byte(`rules: [{id: a, severity: low, assertion: []}]`))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring(`unknown field "assertion"`))
+
+		_, err = configurationaggregatorv1.LoadRuleSet(filepath.Join(os.TempDir(), "no-such-rules.yaml"))
+		Expect(err).ToNot(BeNil())
+	})
+})
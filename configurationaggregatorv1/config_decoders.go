@@ -0,0 +1,188 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configurationaggregatorv1
+
+import (
+	"encoding/json"
+	"sync"
+
+	common "github.com/IBM/configuration-aggregator-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// ConfigDecoder decodes the properties of a Configuration into a strongly typed value.
+type ConfigDecoder func(configuration *Configuration) (interface{}, error)
+
+// JSONConfigDecoder returns a ConfigDecoder that decodes the properties of a Configuration into a *T
+// using the JSON field tags of T. Properties that have no matching field in T are ignored.
+func JSONConfigDecoder[T any]() ConfigDecoder {
+	return func(configuration *Configuration) (interface{}, error) {
+		result, err := DecodeConfigurationAs[T](configuration)
+		if err != nil {
+			return nil, err
+		}
+		return result, nil
+	}
+}
+
+// DecodeConfigurationAs decodes the properties of a Configuration into a *T
+// using the JSON field tags of T. Properties that have no matching field in T are ignored.
+func DecodeConfigurationAs[T any](configuration *Configuration) (result *T, err error) {
+	result = new(T)
+	if configuration == nil {
+		return
+	}
+	buffer, err := json.Marshal(configuration.GetProperties())
+	if err != nil {
+		err = core.SDKErrorf(err, "", "config-marshal-error", common.GetComponentInfo())
+		return nil, err
+	}
+	err = json.Unmarshal(buffer, result)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "config-decode-error", common.GetComponentInfo())
+		return nil, err
+	}
+	return
+}
+
+// configDecoderKey identifies the resources handled by a ConfigDecoder.
+// An empty field matches any value.
+type configDecoderKey struct {
+	serviceName string
+	configType  string
+}
+
+// ConfigDecoderRegistry holds the ConfigDecoders used to decode the configuration of resources,
+// keyed by the service name and config type found in their About metadata.
+// A ConfigDecoderRegistry is safe for concurrent use.
+type ConfigDecoderRegistry struct {
+	mutex    sync.RWMutex
+	decoders map[configDecoderKey]ConfigDecoder
+}
+
+// NewConfigDecoderRegistry returns an empty ConfigDecoderRegistry.
+func NewConfigDecoderRegistry() *ConfigDecoderRegistry {
+	return &ConfigDecoderRegistry{
+		decoders: make(map[configDecoderKey]ConfigDecoder),
+	}
+}
+
+// Register registers a decoder for the resources with the specified service name and config type.
+// An empty service name or config type matches any value. A previously registered decoder with the same
+// service name and config type is replaced; registering a nil decoder removes it.
+func (registry *ConfigDecoderRegistry) Register(serviceName string, configType string, decoder ConfigDecoder) {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+
+	key := configDecoderKey{serviceName: serviceName, configType: configType}
+	if decoder == nil {
+		delete(registry.decoders, key)
+		return
+	}
+	registry.decoders[key] = decoder
+}
+
+// Lookup returns the decoder registered for the resource described by the specified metadata.
+// A decoder registered for both the service name and the config type takes precedence over one registered
+// for the config type only, which takes precedence over one registered for the service name only.
+func (registry *ConfigDecoderRegistry) Lookup(about *About) (decoder ConfigDecoder, found bool) {
+	if about == nil {
+		return
+	}
+	serviceName := core.StringNilMapper(about.ServiceName)
+	configType := core.StringNilMapper(about.ConfigType)
+
+	registry.mutex.RLock()
+	defer registry.mutex.RUnlock()
+
+	for _, key := range []configDecoderKey{
+		{serviceName: serviceName, configType: configType},
+		{configType: configType},
+		{serviceName: serviceName},
+	} {
+		if key == (configDecoderKey{}) {
+			continue
+		}
+		if decoder, found = registry.decoders[key]; found {
+			return
+		}
+	}
+	return
+}
+
+// DecodeConfig decodes the "config" property of the specified resource configuration.
+// If no decoder is registered for the resource, or if it has no About metadata, the raw map of properties is returned.
+func (registry *ConfigDecoderRegistry) DecodeConfig(config *Config) (interface{}, error) {
+	err := core.ValidateNotNil(config, "config cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return nil, err
+	}
+	result, err := registry.decode(config.About, config.Config)
+	err = core.RepurposeSDKProblem(err, "decode-config-error")
+	return result, err
+}
+
+// DecodeConfigV2 decodes the "config_v2" property of the specified resource configuration.
+// If no decoder is registered for the resource, or if it has no About metadata, the raw map of properties is returned.
+func (registry *ConfigDecoderRegistry) DecodeConfigV2(config *Config) (interface{}, error) {
+	err := core.ValidateNotNil(config, "config cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return nil, err
+	}
+	result, err := registry.decode(config.About, config.ConfigV2)
+	err = core.RepurposeSDKProblem(err, "decode-config-v2-error")
+	return result, err
+}
+
+func (registry *ConfigDecoderRegistry) decode(about *About, configuration *Configuration) (interface{}, error) {
+	if configuration == nil {
+		return nil, nil
+	}
+	decoder, found := registry.Lookup(about)
+	if !found {
+		return configuration.GetProperties(), nil
+	}
+	return decoder(configuration)
+}
+
+// DefaultConfigDecoders is the registry used by Config.DecodeConfig() and Config.DecodeConfigV2().
+// It is pre-populated with decoders for common resource types such as KubernetesClusterConfig and COSBucketConfig.
+var DefaultConfigDecoders = newDefaultConfigDecoderRegistry()
+
+// RegisterConfigDecoder registers a decoder in DefaultConfigDecoders.
+// See ConfigDecoderRegistry.Register() for details.
+func RegisterConfigDecoder(serviceName string, configType string, decoder ConfigDecoder) {
+	DefaultConfigDecoders.Register(serviceName, configType, decoder)
+}
+
+// DecodeConfig decodes the "config" property using DefaultConfigDecoders.
+// If no decoder is registered for the resource, the raw map of properties is returned.
+func (config *Config) DecodeConfig() (interface{}, error) {
+	result, err := DefaultConfigDecoders.DecodeConfig(config)
+	err = core.RepurposeSDKProblem(err, "")
+	return result, err
+}
+
+// DecodeConfigV2 decodes the "config_v2" property using DefaultConfigDecoders.
+// If no decoder is registered for the resource, the raw map of properties is returned.
+func (config *Config) DecodeConfigV2() (interface{}, error) {
+	result, err := DefaultConfigDecoders.DecodeConfigV2(config)
+	err = core.RepurposeSDKProblem(err, "")
+	return result, err
+}
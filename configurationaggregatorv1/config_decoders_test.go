@@ -0,0 +1,173 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configurationaggregatorv1_test
+
+import (
+	"errors"
+
+	"github.com/IBM/configuration-aggregator-go-sdk/configurationaggregatorv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ConfigurationAggregatorV1 config decoders`, func() {
+	newConfig := func(serviceName string, configType string, properties map[string]interface{}) *configurationaggregatorv1.Config {
+		configuration := new(configurationaggregatorv1.Configuration)
+		configuration.SetProperties(properties)
+		return &configurationaggregatorv1.Config{
+			About: &configurationaggregatorv1.About{
+				ServiceName: core.StringPtr(serviceName),
+				ConfigType:  core.StringPtr(configType),
+			},
+			Config:   configuration,
+			ConfigV2: configuration,
+		}
+	}
+
+	It(`Decode a Kubernetes cluster configuration`, func() {
+		config := newConfig("containers-kubernetes", "cluster", map[string]interface{}{
+			"id":                            "cluster1",
+			"masterKubeVersion":             "1.30.2_1520",
+			"workerCount":                   3,
+			"privateServiceEndpointEnabled": true,
+		})
+
+		result, err := config.DecodeConfig()
+		Expect(err).To(BeNil())
+		cluster, ok := result.(*configurationaggregatorv1.KubernetesClusterConfig)
+		Expect(ok).To(BeTrue())
+		Expect(cluster.ID).To(Equal(core.StringPtr("cluster1")))
+		Expect(cluster.MasterKubeVersion).To(Equal(core.StringPtr("1.30.2_1520")))
+		Expect(cluster.WorkerCount).To(Equal(core.Int64Ptr(3)))
+		Expect(cluster.PrivateServiceEndpointEnabled).To(Equal(core.BoolPtr(true)))
+
+		result, err = config.DecodeConfigV2()
+		Expect(err).To(BeNil())
+		Expect(result).To(BeAssignableToTypeOf(&configurationaggregatorv1.KubernetesClusterConfig{}))
+	})
+	It(`Decode a Cloud Object Storage bucket configuration`, func() {
+		config := newConfig("cloud-object-storage", "bucket", map[string]interface{}{
+			"name":         "bucket1",
+			"time_created": "2024-01-01T12:00:00.000Z",
+			"firewall": map[string]interface{}{
+				"allowed_ip": []interface{}{"10.0.0.0/8"},
+			},
+			"activity_tracking": map[string]interface{}{
+				"read_data_events": false,
+			},
+		})
+
+		result, err := config.DecodeConfig()
+		Expect(err).To(BeNil())
+		bucket, ok := result.(*configurationaggregatorv1.COSBucketConfig)
+		Expect(ok).To(BeTrue())
+		Expect(bucket.Name).To(Equal(core.StringPtr("bucket1")))
+		Expect(bucket.TimeCreated).To(Equal(CreateMockDateTime("2024-01-01T12:00:00.000Z")))
+		Expect(bucket.Firewall.AllowedIP).To(Equal([]string{"10.0.0.0/8"}))
+		Expect(bucket.ActivityTracking.ReadDataEvents).To(Equal(core.BoolPtr(false)))
+	})
+	It(`Decode IAM configurations`, func() {
+		result, err := newConfig("iam-groups", "access_group", map[string]interface{}{"name": "admins"}).DecodeConfig()
+		Expect(err).To(BeNil())
+		Expect(result.(*configurationaggregatorv1.IamAccessGroupConfig).Name).To(Equal(core.StringPtr("admins")))
+
+		result, err = newConfig("iam-identity", "account_settings", map[string]interface{}{"mfa": "TOTP"}).DecodeConfig()
+		Expect(err).To(BeNil())
+		Expect(result.(*configurationaggregatorv1.IamAccountSettingsConfig).Mfa).To(Equal(core.StringPtr("TOTP")))
+	})
+	It(`Fall back to the raw map of properties`, func() {
+		properties := map[string]interface{}{"key": "value"}
+		result, err := newConfig("kms", "key", properties).DecodeConfig()
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal(properties))
+
+		result, err = (&configurationaggregatorv1.Config{About: &configurationaggregatorv1.About{}}).DecodeConfigV2()
+		Expect(err).To(BeNil())
+		Expect(result).To(BeNil())
+	})
+	It(`Decode configurations without metadata`, func() {
+		properties := map[string]interface{}{"key": "value"}
+		config := newConfig("kubernetes", "cluster", properties)
+		config.About = nil
+		result, err := config.DecodeConfig()
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal(properties))
+
+		var nilConfig *configurationaggregatorv1.Config
+		_, err = nilConfig.DecodeConfig()
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("config cannot be nil"))
+		_, err = configurationaggregatorv1.NewConfigDecoderRegistry().DecodeConfigV2(nil)
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Return an error for a configuration that does not match the type`, func() {
+		_, err := newConfig("cloud-object-storage", "bucket", map[string]interface{}{"name": 42}).DecodeConfig()
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Register custom decoders`, func() {
+		type kmsKey struct {
+			Rotation *struct {
+				Enabled *bool `json:"enabled"`
+			} `json:"rotation"`
+		}
+
+		registry := configurationaggregatorv1.NewConfigDecoderRegistry()
+		registry.Register("kms", "", func(*configurationaggregatorv1.Configuration) (interface{}, error) {
+			return "service", nil
+		})
+		registry.Register("", "key", func(*configurationaggregatorv1.Configuration) (interface{}, error) {
+			return "type", nil
+		})
+		registry.Register("kms", "key", configurationaggregatorv1.JSONConfigDecoder[kmsKey]())
+
+		config := newConfig("kms", "key", map[string]interface{}{"rotation": map[string]interface{}{"enabled": true}})
+		result, err := registry.DecodeConfig(config)
+		Expect(err).To(BeNil())
+		Expect(*result.(*kmsKey).Rotation.Enabled).To(BeTrue())
+
+		registry.Register("kms", "key", nil)
+		result, err = registry.DecodeConfig(config)
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal("type"))
+
+		result, err = registry.DecodeConfig(newConfig("kms", "instance", nil))
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal("service"))
+
+		registry.Register("", "key", func(*configurationaggregatorv1.Configuration) (interface{}, error) {
+			return nil, errors.New("decode failure")
+		})
+		_, err = registry.DecodeConfig(config)
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Register custom decoders in the default registry`, func() {
+		configurationaggregatorv1.RegisterConfigDecoder("test-service", "", func(*configurationaggregatorv1.Configuration) (interface{}, error) {
+			return "custom", nil
+		})
+		defer configurationaggregatorv1.RegisterConfigDecoder("test-service", "", nil)
+
+		result, err := newConfig("test-service", "anything", nil).DecodeConfig()
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal("custom"))
+	})
+	It(`Invoke DecodeConfigurationAs successfully`, func() {
+		result, err := configurationaggregatorv1.DecodeConfigurationAs[configurationaggregatorv1.COSBucketConfig](nil)
+		Expect(err).To(BeNil())
+		Expect(result).To(Equal(&configurationaggregatorv1.COSBucketConfig{}))
+	})
+})
@@ -0,0 +1,780 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configurationaggregatorv1
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	common "github.com/IBM/configuration-aggregator-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/go-openapi/strfmt"
+)
+
+// ConfigQuery : A compiled query over resource configurations. A query is a boolean expression evaluated against
+// a resource configuration, for example:
+//
+//	service_name == "kms" && config.rotation.enabled == false && "env:prod" in user_tags
+//
+// Operands are literals (strings in double quotes, numbers, true, false, null), lists of literals in brackets,
+// and fields of the resource configuration:
+//
+//	service_name, location, user_tags, ...   the properties of About, also available as about.service_name, ...
+//	config.<path>                            a property of the "config" property, e.g. config.encryption.enabled
+//	config_v2.<path>                         a property of the "config_v2" property
+//
+// Paths use the syntax of Configuration.Lookup(), without wildcards: config.rules[0].port, config.labels["app.name"].
+// A field that does not exist evaluates to null.
+//
+// Operators, from lowest to highest precedence:
+//
+//	||                              logical or
+//	&&                              logical and
+//	!                               logical not
+//	== != < <= > >=                 comparisons; numbers compare by value, date-times by time, other strings lexically
+//	in, contains                    x in list, list contains x, "sub" in string, key in object
+//	matches                         string matches regular expression, e.g. resource_name matches "^prod-"
+//
+// Functions: exists(field) is true if the field exists, len(value) is the length of a string, list or object.
+// Parentheses group sub-expressions. A ConfigQuery is safe for concurrent use.
+type ConfigQuery struct {
+	source string
+	root   queryNode
+}
+
+// CompileConfigQuery compiles a query expression. See ConfigQuery for the syntax.
+func CompileConfigQuery(expression string) (query *ConfigQuery, err error) {
+	parser := &queryParser{source: expression}
+	err = parser.tokenize()
+	if err == nil {
+		var root queryNode
+		root, err = parser.parseExpression()
+		if err == nil && parser.peek().kind != tokenEOF {
+			err = parser.errorf(parser.peek(), "unexpected %s", parser.peek())
+		}
+		query = &ConfigQuery{source: expression, root: root}
+	}
+	if err != nil {
+		err = core.SDKErrorf(err, "", "invalid-query", common.GetComponentInfo())
+		return nil, err
+	}
+	return
+}
+
+// MustCompileConfigQuery is like CompileConfigQuery but panics if the expression cannot be compiled.
+// It simplifies the initialization of global variables holding queries.
+func MustCompileConfigQuery(expression string) *ConfigQuery {
+	query, err := CompileConfigQuery(expression)
+	if err != nil {
+		panic(err)
+	}
+	return query
+}
+
+// String returns the source expression of the query.
+func (query *ConfigQuery) String() string {
+	return query.source
+}
+
+// Evaluate evaluates the query against a resource configuration. An error is returned if the query
+// does not evaluate to a boolean, e.g. if a field used as a condition is a string.
+func (query *ConfigQuery) Evaluate(config *Config) (bool, error) {
+	value, err := evaluateCondition(query.root, config)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "query-evaluation-error", common.GetComponentInfo())
+	}
+	return value, err
+}
+
+// Matches returns true if the query evaluates to true against the resource configuration.
+// Evaluation errors are treated as a non-match.
+func (query *ConfigQuery) Matches(config *Config) bool {
+	value, err := query.Evaluate(config)
+	return err == nil && value
+}
+
+// FilterConfigs returns the resource configurations that match the query.
+func (query *ConfigQuery) FilterConfigs(configs []Config) (result []Config) {
+	for i := range configs {
+		if query.Matches(&configs[i]) {
+			result = append(result, configs[i])
+		}
+	}
+	return
+}
+
+// Filter returns an iterator over the resource configurations of the sequence that match the query,
+// e.g. to filter the results of ConfigsPager.All() or ConfigurationAggregatorV1.AllConfigs().
+// Errors of the sequence are passed through.
+func (query *ConfigQuery) Filter(seq iter.Seq2[Config, error]) iter.Seq2[Config, error] {
+	return func(yield func(Config, error) bool) {
+		for config, err := range seq {
+			if err != nil || query.Matches(&config) {
+				if !yield(config, err) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// GetAllMatchingWithContext returns the remaining results of the pager that match the query.
+// The filters of the pager are applied by the service, and the query is applied to the results.
+func (pager *ConfigsPager) GetAllMatchingWithContext(ctx context.Context, query *ConfigQuery) (allItems []Config, err error) {
+	for config, err := range query.Filter(pager.All(ctx)) {
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "error-getting-matching-items")
+			return nil, err
+		}
+		allItems = append(allItems, config)
+	}
+	return
+}
+
+// Query returns the resource configurations of the snapshot that match the query.
+func (snapshot *Snapshot) Query(query *ConfigQuery) []Config {
+	return query.FilterConfigs(snapshot.Configs)
+}
+
+// aboutQueryFields are the properties of About available in queries.
+var aboutQueryFields = map[string]func(about *About) interface{}{
+	"account_id":               func(about *About) interface{} { return stringOrNil(about.AccountID) },
+	"config_type":              func(about *About) interface{} { return stringOrNil(about.ConfigType) },
+	"resource_crn":             func(about *About) interface{} { return stringOrNil(about.ResourceCrn) },
+	"resource_group_id":        func(about *About) interface{} { return stringOrNil(about.ResourceGroupID) },
+	"resource_group_name":      func(about *About) interface{} { return stringOrNil(about.ResourceGroupName) },
+	"service_name":             func(about *About) interface{} { return stringOrNil(about.ServiceName) },
+	"resource_name":            func(about *About) interface{} { return stringOrNil(about.ResourceName) },
+	"location":                 func(about *About) interface{} { return stringOrNil(about.Location) },
+	"type":                     func(about *About) interface{} { return stringOrNil(about.Type) },
+	"type_id":                  func(about *About) interface{} { return stringOrNil(about.TypeID) },
+	"access_tags":              func(about *About) interface{} { return stringsOrEmpty(about.AccessTags) },
+	"user_tags":                func(about *About) interface{} { return stringsOrEmpty(about.UserTags) },
+	"service_tags":             func(about *About) interface{} { return stringsOrEmpty(about.ServiceTags) },
+	"catalog_tags":             func(about *About) interface{} { return stringsOrEmpty(about.CatalogTags) },
+	"last_config_refresh_time": func(about *About) interface{} { return dateTimeOrNil(about.LastConfigRefreshTime) },
+	"created_at":               func(about *About) interface{} { return dateTimeOrNil(about.CreatedAt) },
+}
+
+func stringOrNil(value *string) interface{} {
+	if value == nil {
+		return nil
+	}
+	return *value
+}
+
+func stringsOrEmpty(values []string) interface{} {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
+
+func dateTimeOrNil(value *strfmt.DateTime) interface{} {
+	if value == nil {
+		return nil
+	}
+	return value.String()
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenString
+	tokenNumber
+	tokenOperator
+)
+
+type queryToken struct {
+	kind  tokenKind
+	text  string
+	value interface{}
+	pos   int
+}
+
+// String returns a description of the token for error messages.
+func (token queryToken) String() string {
+	if token.kind == tokenEOF {
+		return "end of expression"
+	}
+	return fmt.Sprintf("'%s'", token.text)
+}
+
+// queryOperators are the operators and punctuation of the language, longest first.
+var queryOperators = []string{"==", "!=", "<=", ">=", "&&", "||", "<", ">", "!", "(", ")", "[", "]", ",", "."}
+
+type queryParser struct {
+	source   string
+	tokens   []queryToken
+	position int
+}
+
+func (parser *queryParser) errorf(token queryToken, format string, args ...interface{}) error {
+	// The position of the token is a byte offset, reported as a character position.
+	position := utf8.RuneCountInString(parser.source[:token.pos]) + 1
+	return fmt.Errorf("invalid query at position %d: %s", position, fmt.Sprintf(format, args...))
+}
+
+func (parser *queryParser) tokenize() error {
+	source := parser.source
+	for i := 0; i < len(source); {
+		c, size := utf8.DecodeRuneInString(source[i:])
+		switch {
+		case unicode.IsSpace(c):
+			i += size
+		case c == '"':
+			end := i + 1
+			for end < len(source) && source[end] != '"' {
+				if source[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			if end >= len(source) {
+				return parser.errorf(queryToken{pos: i}, "unterminated string")
+			}
+			value, err := strconv.Unquote(source[i : end+1])
+			if err != nil {
+				return parser.errorf(queryToken{pos: i}, "invalid string %s", source[i:end+1])
+			}
+			parser.tokens = append(parser.tokens, queryToken{kind: tokenString, text: source[i : end+1], value: value, pos: i})
+			i = end + 1
+		case c == '-' || (c >= '0' && c <= '9'):
+			end := i + 1
+			for end < len(source) && strings.ContainsRune("0123456789.eE+-", rune(source[end])) {
+				end++
+			}
+			value, err := strconv.ParseFloat(source[i:end], 64)
+			if err != nil {
+				return parser.errorf(queryToken{pos: i}, "invalid number %s", source[i:end])
+			}
+			parser.tokens = append(parser.tokens, queryToken{kind: tokenNumber, text: source[i:end], value: value, pos: i})
+			i = end
+		case c == '_' || unicode.IsLetter(c):
+			end := i + size
+			for end < len(source) {
+				next, nextSize := utf8.DecodeRuneInString(source[end:])
+				if next != '_' && next != '-' && !unicode.IsLetter(next) && !unicode.IsDigit(next) {
+					break
+				}
+				end += nextSize
+			}
+			parser.tokens = append(parser.tokens, queryToken{kind: tokenIdent, text: source[i:end], pos: i})
+			i = end
+		default:
+			found := false
+			for _, operator := range queryOperators {
+				if strings.HasPrefix(source[i:], operator) {
+					parser.tokens = append(parser.tokens, queryToken{kind: tokenOperator, text: operator, pos: i})
+					i += len(operator)
+					found = true
+					break
+				}
+			}
+			if !found {
+				return parser.errorf(queryToken{pos: i}, "unexpected character '%c'", c)
+			}
+		}
+	}
+	parser.tokens = append(parser.tokens, queryToken{kind: tokenEOF, pos: len(source)})
+	return nil
+}
+
+func (parser *queryParser) peek() queryToken {
+	return parser.tokens[parser.position]
+}
+
+func (parser *queryParser) next() queryToken {
+	token := parser.tokens[parser.position]
+	if token.kind != tokenEOF {
+		parser.position++
+	}
+	return token
+}
+
+// accept consumes the next token if it is the specified operator or keyword.
+func (parser *queryParser) accept(text string) bool {
+	token := parser.peek()
+	if (token.kind == tokenOperator || token.kind == tokenIdent) && token.text == text {
+		parser.position++
+		return true
+	}
+	return false
+}
+
+func (parser *queryParser) expect(text string) error {
+	if !parser.accept(text) {
+		return parser.errorf(parser.peek(), "expected '%s', found %s", text, parser.peek())
+	}
+	return nil
+}
+
+type queryNode interface{}
+
+type literalNode struct {
+	value interface{}
+}
+
+type listNode struct {
+	items []queryNode
+}
+
+type fieldNode struct {
+	// The About property, or "config" or "config_v2".
+	root     string
+	segments []pathSegment
+}
+
+type notNode struct {
+	operand queryNode
+}
+
+type logicalNode struct {
+	operator    string
+	left, right queryNode
+}
+
+type comparisonNode struct {
+	operator    string
+	left, right queryNode
+	regexp      *regexp.Regexp
+}
+
+type callNode struct {
+	function string
+	argument queryNode
+}
+
+func (parser *queryParser) parseExpression() (queryNode, error) {
+	return parser.parseOr()
+}
+
+func (parser *queryParser) parseOr() (queryNode, error) {
+	left, err := parser.parseAnd()
+	for err == nil && parser.accept("||") {
+		var right queryNode
+		right, err = parser.parseAnd()
+		left = &logicalNode{operator: "||", left: left, right: right}
+	}
+	return left, err
+}
+
+func (parser *queryParser) parseAnd() (queryNode, error) {
+	left, err := parser.parseNot()
+	for err == nil && parser.accept("&&") {
+		var right queryNode
+		right, err = parser.parseNot()
+		left = &logicalNode{operator: "&&", left: left, right: right}
+	}
+	return left, err
+}
+
+func (parser *queryParser) parseNot() (queryNode, error) {
+	if parser.accept("!") {
+		operand, err := parser.parseNot()
+		return &notNode{operand: operand}, err
+	}
+	return parser.parseComparison()
+}
+
+func (parser *queryParser) parseComparison() (queryNode, error) {
+	left, err := parser.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	token := parser.peek()
+	switch token.text {
+	case "==", "!=", "<", "<=", ">", ">=", "in", "contains", "matches":
+	default:
+		return left, nil
+	}
+	parser.next()
+	right, err := parser.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	node := &comparisonNode{operator: token.text, left: left, right: right}
+	if token.text == "matches" {
+		pattern, ok := right.(*literalNode)
+		if !ok {
+			return nil, parser.errorf(token, "the right operand of 'matches' must be a string")
+		}
+		source, ok := pattern.value.(string)
+		if !ok {
+			return nil, parser.errorf(token, "the right operand of 'matches' must be a string")
+		}
+		node.regexp, err = regexp.Compile(source)
+		if err != nil {
+			return nil, parser.errorf(token, "invalid regular expression: %s", err.Error())
+		}
+	}
+	return node, nil
+}
+
+func (parser *queryParser) parseOperand() (queryNode, error) {
+	token := parser.next()
+	switch token.kind {
+	case tokenString, tokenNumber:
+		return &literalNode{value: token.value}, nil
+	case tokenIdent:
+		switch token.text {
+		case "true":
+			return &literalNode{value: true}, nil
+		case "false":
+			return &literalNode{value: false}, nil
+		case "null":
+			return &literalNode{value: nil}, nil
+		case "exists", "len":
+			if parser.peek().text == "(" {
+				return parser.parseCall(token)
+			}
+		}
+		return parser.parseField(token)
+	case tokenOperator:
+		switch token.text {
+		case "(":
+			node, err := parser.parseExpression()
+			if err == nil {
+				err = parser.expect(")")
+			}
+			return node, err
+		case "[":
+			return parser.parseList()
+		}
+	}
+	return nil, parser.errorf(token, "unexpected %s", token)
+}
+
+func (parser *queryParser) parseList() (queryNode, error) {
+	list := &listNode{}
+	if parser.accept("]") {
+		return list, nil
+	}
+	for {
+		item, err := parser.parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		list.items = append(list.items, item)
+		if parser.accept("]") {
+			return list, nil
+		}
+		if err = parser.expect(","); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (parser *queryParser) parseCall(function queryToken) (queryNode, error) {
+	parser.next()
+	argument, err := parser.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	if function.text == "exists" {
+		if _, ok := argument.(*fieldNode); !ok {
+			return nil, parser.errorf(function, "the argument of 'exists' must be a field")
+		}
+	}
+	if err = parser.expect(")"); err != nil {
+		return nil, err
+	}
+	return &callNode{function: function.text, argument: argument}, nil
+}
+
+func (parser *queryParser) parseField(first queryToken) (queryNode, error) {
+	node := &fieldNode{root: first.text}
+	switch first.text {
+	case "config", "config_v2":
+	case "about":
+		if err := parser.expect("."); err != nil {
+			return nil, err
+		}
+		token := parser.next()
+		if token.kind != tokenIdent {
+			return nil, parser.errorf(token, "expected a property of about, found %s", token)
+		}
+		node.root = token.text
+	}
+	if _, found := aboutQueryFields[node.root]; !found && node.root != "config" && node.root != "config_v2" {
+		return nil, parser.errorf(first, "unknown field '%s'", node.root)
+	}
+
+	for {
+		switch {
+		case parser.accept("."):
+			token := parser.next()
+			if token.kind != tokenIdent {
+				return nil, parser.errorf(token, "expected a property name, found %s", token)
+			}
+			node.segments = append(node.segments, pathSegment{key: token.text})
+		case parser.accept("["):
+			token := parser.next()
+			switch token.kind {
+			case tokenString:
+				node.segments = append(node.segments, pathSegment{key: token.value.(string)})
+			case tokenNumber:
+				index := token.value.(float64)
+				if index < 0 || index != float64(int(index)) {
+					return nil, parser.errorf(token, "invalid index %s", token.text)
+				}
+				node.segments = append(node.segments, pathSegment{index: int(index), isIndex: true})
+			default:
+				return nil, parser.errorf(token, "expected an index or a quoted property name, found %s", token)
+			}
+			if err := parser.expect("]"); err != nil {
+				return nil, err
+			}
+		default:
+			return node, nil
+		}
+	}
+}
+
+// undefined is the value of a field that does not exist.
+type undefined struct{}
+
+func evaluateCondition(node queryNode, config *Config) (bool, error) {
+	value, err := evaluateNode(node, config)
+	if err != nil {
+		return false, err
+	}
+	switch v := value.(type) {
+	case bool:
+		return v, nil
+	case nil, undefined:
+		return false, nil
+	}
+	return false, fmt.Errorf("the condition evaluates to %s, not a boolean", describeValueType(value))
+}
+
+func evaluateNode(node queryNode, config *Config) (interface{}, error) {
+	switch n := node.(type) {
+	case *literalNode:
+		return n.value, nil
+	case *listNode:
+		values := make([]interface{}, len(n.items))
+		for i, item := range n.items {
+			value, err := evaluateNode(item, config)
+			if err != nil {
+				return nil, err
+			}
+			values[i] = value
+		}
+		return values, nil
+	case *fieldNode:
+		return evaluateField(n, config), nil
+	case *notNode:
+		value, err := evaluateCondition(n.operand, config)
+		return !value, err
+	case *logicalNode:
+		left, err := evaluateCondition(n.left, config)
+		if err != nil {
+			return nil, err
+		}
+		if (n.operator == "&&" && !left) || (n.operator == "||" && left) {
+			return left, nil
+		}
+		return evaluateCondition(n.right, config)
+	case *comparisonNode:
+		left, err := evaluateNode(n.left, config)
+		if err != nil {
+			return nil, err
+		}
+		right, err := evaluateNode(n.right, config)
+		if err != nil {
+			return nil, err
+		}
+		return compareValues(n, left, right), nil
+	case *callNode:
+		argument, err := evaluateNode(n.argument, config)
+		if err != nil {
+			return nil, err
+		}
+		if n.function == "exists" {
+			_, isUndefined := argument.(undefined)
+			return !isUndefined, nil
+		}
+		return valueLength(argument), nil
+	}
+	return nil, fmt.Errorf("unsupported expression %T", node)
+}
+
+func evaluateField(node *fieldNode, config *Config) interface{} {
+	var root interface{}
+	switch node.root {
+	case "config", "config_v2":
+		configuration := config.Config
+		if node.root == "config_v2" {
+			configuration = config.ConfigV2
+		}
+		if configuration == nil {
+			return undefined{}
+		}
+		root = configuration.GetProperties()
+		if root == nil {
+			root = map[string]interface{}{}
+		}
+	default:
+		if config.About == nil {
+			return undefined{}
+		}
+		root = aboutQueryFields[node.root](config.About)
+		if root == nil {
+			return undefined{}
+		}
+	}
+	value, found, err := lookupPath(root, node.segments)
+	if err != nil || !found {
+		return undefined{}
+	}
+	return value
+}
+
+func compareValues(node *comparisonNode, left interface{}, right interface{}) bool {
+	if _, ok := left.(undefined); ok {
+		left = nil
+	}
+	if _, ok := right.(undefined); ok {
+		right = nil
+	}
+	switch node.operator {
+	case "==":
+		return queryValuesEqual(left, right)
+	case "!=":
+		return !queryValuesEqual(left, right)
+	case "<", "<=", ">", ">=":
+		order, ok := orderValues(left, right)
+		if !ok {
+			return false
+		}
+		switch node.operator {
+		case "<":
+			return order < 0
+		case "<=":
+			return order <= 0
+		case ">":
+			return order > 0
+		default:
+			return order >= 0
+		}
+	case "in":
+		return containsValue(right, left)
+	case "contains":
+		return containsValue(left, right)
+	case "matches":
+		s, ok := left.(string)
+		return ok && node.regexp.MatchString(s)
+	}
+	return false
+}
+
+// queryValuesEqual returns true if two values are equal, comparing numbers by value.
+func queryValuesEqual(left interface{}, right interface{}) bool {
+	if leftArray, ok := asArray(left); ok {
+		rightArray, ok := asArray(right)
+		if !ok || len(leftArray) != len(rightArray) {
+			return false
+		}
+		for i := range leftArray {
+			if !queryValuesEqual(leftArray[i], rightArray[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	return scalarsEqual(left, right)
+}
+
+// orderValues compares two numbers, date-times or strings.
+func orderValues(left interface{}, right interface{}) (int, bool) {
+	if leftNumber, ok := toFloat64(left); ok {
+		rightNumber, ok := toFloat64(right)
+		if !ok {
+			return 0, false
+		}
+		switch {
+		case leftNumber < rightNumber:
+			return -1, true
+		case leftNumber > rightNumber:
+			return 1, true
+		}
+		return 0, true
+	}
+	leftString, ok := left.(string)
+	if !ok {
+		return 0, false
+	}
+	rightString, ok := right.(string)
+	if !ok {
+		return 0, false
+	}
+	if leftTime, ok := toTime(leftString); ok {
+		if rightTime, ok := toTime(rightString); ok {
+			return leftTime.Compare(rightTime), true
+		}
+	}
+	return strings.Compare(leftString, rightString), true
+}
+
+// containsValue returns true if the container holds the value: an element of a list, a substring of a string,
+// or a property name of an object.
+func containsValue(container interface{}, value interface{}) bool {
+	if s, ok := container.(string); ok {
+		substring, ok := value.(string)
+		return ok && strings.Contains(s, substring)
+	}
+	if array, ok := asArray(container); ok {
+		for _, element := range array {
+			if queryValuesEqual(element, value) {
+				return true
+			}
+		}
+		return false
+	}
+	if object, ok := asObject(container); ok && container != nil {
+		key, ok := value.(string)
+		if !ok {
+			return false
+		}
+		_, found := object[key]
+		return found
+	}
+	return false
+}
+
+// valueLength returns the length of a string, list or object, or null for other values.
+func valueLength(value interface{}) interface{} {
+	if s, ok := value.(string); ok {
+		return float64(len([]rune(s)))
+	}
+	if array, ok := asArray(value); ok {
+		return float64(len(array))
+	}
+	if object, ok := asObject(value); ok && value != nil {
+		return float64(len(object))
+	}
+	return nil
+}
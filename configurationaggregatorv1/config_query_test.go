@@ -0,0 +1,178 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configurationaggregatorv1_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/configuration-aggregator-go-sdk/configurationaggregatorv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ConfigurationAggregatorV1 configuration queries`, func() {
+	var key configurationaggregatorv1.Config
+	var bucket configurationaggregatorv1.Config
+
+	BeforeEach(func() {
+		key = mockConfig("crn:key1", "kms", "us-south", []string{"env:prod", "team:security"})
+		key.About.ResourceName = core.StringPtr("prod-root-key")
+		key.Config.SetProperty("rotation", map[string]interface{}{"enabled": false, "interval_month": float64(3)})
+		key.Config.SetProperty("labels", map[string]interface{}{"app.kubernetes.io/name": "vault"})
+		key.Config.SetProperty("aliases", []interface{}{"root", "master"})
+
+		bucket = mockConfig("crn:bucket1", "cloud-object-storage", "global", nil)
+		bucket.Config.SetProperty("versioning", map[string]interface{}{"enabled": true})
+	})
+
+	evaluate := func(expression string, config *configurationaggregatorv1.Config) bool {
+		query, err := configurationaggregatorv1.CompileConfigQuery(expression)
+		Expect(err).To(BeNil(), expression)
+		Expect(query.String()).To(Equal(expression))
+		result, err := query.Evaluate(config)
+		Expect(err).To(BeNil(), expression)
+		return result
+	}
+
+	It(`Evaluate queries successfully`, func() {
+		for _, expression := range []string{
+			`service_name == "kms" && config.rotation.enabled == false && "env:prod" in user_tags`,
+			`about.location == "us-south"`,
+			`config.rotation.interval_month >= 3 && config.rotation.interval_month < 3.5`,
+			`config.labels["app.kubernetes.io/name"] == "vault"`,
+			`config.aliases[1] == "master"`,
+			`config.aliases contains "root" && "root" in config.aliases`,
+			`location in ["us-south", "eu-de"]`,
+			`user_tags == ["env:prod", "team:security"]`,
+			`resource_name matches "^prod-" && "root" in resource_name`,
+			`"rotation" in config`,
+			`!(service_name == "is") && !config.rotation.enabled`,
+			`service_name == "is" || len(user_tags) == 2`,
+			`config.missing == null && !exists(config.missing) && exists(config.rotation)`,
+			`config.missing != "x" && config_v2 == null`,
+			`last_config_refresh_time > "2018-12-31T00:00:00Z" && last_config_refresh_time <= "2019-01-01T12:00:00.000Z"`,
+			`resource_group_name > "A" && type_id == null`,
+			`config.rotation.enabled.nested == null`,
+			`config.key-protect == null`,
+			`-1 < 0`,
+		} {
+			Expect(evaluate(expression, &key)).To(BeTrue(), expression)
+		}
+
+		for _, expression := range []string{
+			`service_name == "cloud-object-storage"`,
+			`config.rotation.enabled`,
+			`"env:dev" in user_tags`,
+			`config.rotation.interval_month > "2"`,
+			`config.missing`,
+			`resource_name matches "^dev-"`,
+			`config.aliases contains 1`,
+		} {
+			Expect(evaluate(expression, &key)).To(BeFalse(), expression)
+		}
+
+		Expect(evaluate(`config.versioning.enabled && len(user_tags) == 0`, &bucket)).To(BeTrue())
+		Expect(evaluate(`service_name == "kms"`, &configurationaggregatorv1.Config{})).To(BeFalse())
+	})
+	It(`Invoke CompileConfigQuery with error`, func() {
+		for _, expression := range []string{
+			``,
+			`service_name ==`,
+			`service_name == "kms" &&`,
+			`unknown_field == 1`,
+			`(service_name == "kms"`,
+			`service_name == "kms")`,
+			`service_name == "kms`,
+			`config.rules[-1] == 1`,
+			`config.rules[x] == 1`,
+			`config. == 1`,
+			`resource_name matches location`,
+			`resource_name matches "("`,
+			`exists("x")`,
+			`location in ["a" "b"]`,
+			`service_name = "kms"`,
+			`1.2.3 == 1`,
+		} {
+			_, err := configurationaggregatorv1.CompileConfigQuery(expression)
+			Expect(err).ToNot(BeNil(), expression)
+			Expect(err.Error()).To(ContainSubstring("invalid query at position"), expression)
+		}
+		Expect(func() { configurationaggregatorv1.MustCompileConfigQuery("==") }).To(Panic())
+	})
+	It(`Evaluate queries with non-ASCII characters`, func() {
+		key.Config.SetProperty("équipe", map[string]interface{}{"propriétaire": "sécurité"})
+		Expect(evaluate(`config.équipe.propriétaire == "sécurité"`, &key)).To(BeTrue())
+		Expect(evaluate(`config.équipe["propriétaire"] != "réseau"`, &key)).To(BeTrue())
+
+		_, err := configurationaggregatorv1.CompileConfigQuery(`config.équipe == "é" ∧ location == "us-south"`)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(Equal("invalid query at position 22: unexpected character '∧'"))
+	})
+	It(`Invoke Evaluate with error`, func() {
+		query := configurationaggregatorv1.MustCompileConfigQuery(`service_name && true`)
+		_, err := query.Evaluate(&key)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("a string, not a boolean"))
+		Expect(query.Matches(&key)).To(BeFalse())
+	})
+	It(`Filter configurations with a query`, func() {
+		query := configurationaggregatorv1.MustCompileConfigQuery(`service_name == "kms"`)
+		configs := []configurationaggregatorv1.Config{key, bucket}
+		Expect(query.FilterConfigs(configs)).To(HaveLen(1))
+
+		snapshot := configurationaggregatorv1.NewSnapshot(time.Now(), configs)
+		Expect(snapshot.Query(query)).To(HaveLen(1))
+
+		testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			if req.URL.Query().Get("start") == "" {
+				fmt.Fprintf(res, `{"next":{"start":"1"},"configs":[%s]}`, mockConfigJSON("crn1"))
+			} else {
+				fmt.Fprintf(res, `{"configs":[%s]}`, mockConfigJSON("crn2"))
+			}
+		}))
+		defer testServer.Close()
+
+		configurationAggregatorService, serviceErr := configurationaggregatorv1.NewConfigurationAggregatorV1(&configurationaggregatorv1.ConfigurationAggregatorV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+
+		pager, err := configurationAggregatorService.NewConfigsPager(configurationAggregatorService.NewListConfigsOptions())
+		Expect(err).To(BeNil())
+		matching, err := pager.GetAllMatchingWithContext(context.Background(), configurationaggregatorv1.MustCompileConfigQuery(`resource_crn == "crn2"`))
+		Expect(err).To(BeNil())
+		Expect(matching).To(HaveLen(1))
+		Expect(*matching[0].About.ResourceCrn).To(Equal("crn2"))
+
+		var crns []string
+		for config, err := range query.Filter(configurationAggregatorService.AllConfigs(context.Background(), nil)) {
+			Expect(err).To(BeNil())
+			crns = append(crns, *config.About.ResourceCrn)
+		}
+		Expect(crns).To(BeEmpty())
+	})
+})
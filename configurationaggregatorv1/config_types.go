@@ -0,0 +1,222 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configurationaggregatorv1
+
+import (
+	"github.com/go-openapi/strfmt"
+)
+
+// Service names and config types of the resources for which DefaultConfigDecoders provides a typed decoder.
+const (
+	ServiceName_ContainersKubernetes = "containers-kubernetes"
+	ServiceName_CloudObjectStorage   = "cloud-object-storage"
+	ServiceName_IamGroups            = "iam-groups"
+	ServiceName_IamIdentity          = "iam-identity"
+
+	ConfigType_Bucket          = "bucket"
+	ConfigType_AccountSettings = "account_settings"
+)
+
+// newDefaultConfigDecoderRegistry returns a ConfigDecoderRegistry holding the decoders of the types defined in this file.
+func newDefaultConfigDecoderRegistry() *ConfigDecoderRegistry {
+	registry := NewConfigDecoderRegistry()
+	registry.Register(ServiceName_ContainersKubernetes, "", JSONConfigDecoder[KubernetesClusterConfig]())
+	registry.Register(ServiceName_CloudObjectStorage, ConfigType_Bucket, JSONConfigDecoder[COSBucketConfig]())
+	registry.Register(ServiceName_IamGroups, "", JSONConfigDecoder[IamAccessGroupConfig]())
+	registry.Register(ServiceName_IamIdentity, ConfigType_AccountSettings, JSONConfigDecoder[IamAccountSettingsConfig]())
+	return registry
+}
+
+// KubernetesClusterConfig : The configuration of a Kubernetes or Red Hat OpenShift cluster.
+type KubernetesClusterConfig struct {
+	// The ID of the cluster.
+	ID *string `json:"id,omitempty"`
+
+	// The name of the cluster.
+	Name *string `json:"name,omitempty"`
+
+	// The CRN of the cluster.
+	Crn *string `json:"crn,omitempty"`
+
+	// The region in which the cluster is created.
+	Region *string `json:"region,omitempty"`
+
+	// The location (zone or metro) in which the cluster is created.
+	Location *string `json:"location,omitempty"`
+
+	// The ID of the resource group of the cluster.
+	ResourceGroup *string `json:"resourceGroup,omitempty"`
+
+	// The name of the resource group of the cluster.
+	ResourceGroupName *string `json:"resourceGroupName,omitempty"`
+
+	// The type of the cluster, e.g. kubernetes or openshift.
+	Type *string `json:"type,omitempty"`
+
+	// The infrastructure provider of the cluster, e.g. classic or vpc-gen2.
+	Provider *string `json:"provider,omitempty"`
+
+	// The state of the cluster.
+	State *string `json:"state,omitempty"`
+
+	// The Kubernetes version of the cluster master.
+	MasterKubeVersion *string `json:"masterKubeVersion,omitempty"`
+
+	// The number of worker nodes in the cluster.
+	WorkerCount *int64 `json:"workerCount,omitempty"`
+
+	// Whether the private service endpoint of the cluster master is enabled.
+	PrivateServiceEndpointEnabled *bool `json:"privateServiceEndpointEnabled,omitempty"`
+
+	// Whether the public service endpoint of the cluster master is enabled.
+	PublicServiceEndpointEnabled *bool `json:"publicServiceEndpointEnabled,omitempty"`
+
+	// The IDs of the VPCs of the cluster.
+	Vpcs []string `json:"vpcs,omitempty"`
+
+	// The date the cluster was created.
+	CreatedDate *string `json:"createdDate,omitempty"`
+}
+
+// COSBucketConfig : The configuration of a Cloud Object Storage bucket.
+type COSBucketConfig struct {
+	// The name of the bucket.
+	Name *string `json:"name,omitempty"`
+
+	// The CRN of the bucket.
+	Crn *string `json:"crn,omitempty"`
+
+	// The ID of the Cloud Object Storage instance holding the bucket.
+	ServiceInstanceID *string `json:"service_instance_id,omitempty"`
+
+	// The CRN of the Cloud Object Storage instance holding the bucket.
+	ServiceInstanceCrn *string `json:"service_instance_crn,omitempty"`
+
+	// The creation time of the bucket.
+	TimeCreated *strfmt.DateTime `json:"time_created,omitempty"`
+
+	// The last time the bucket metadata was updated.
+	TimeUpdated *strfmt.DateTime `json:"time_updated,omitempty"`
+
+	// The number of objects in the bucket.
+	ObjectCount *int64 `json:"object_count,omitempty"`
+
+	// The total size of the objects in the bucket, in bytes.
+	BytesUsed *int64 `json:"bytes_used,omitempty"`
+
+	// The maximum size of the bucket, in bytes.
+	HardQuota *int64 `json:"hard_quota,omitempty"`
+
+	// The network access restrictions of the bucket.
+	Firewall *COSBucketFirewall `json:"firewall,omitempty"`
+
+	// The Activity Tracker settings of the bucket.
+	ActivityTracking *COSBucketActivityTracking `json:"activity_tracking,omitempty"`
+
+	// The metrics monitoring settings of the bucket.
+	MetricsMonitoring *COSBucketMetricsMonitoring `json:"metrics_monitoring,omitempty"`
+}
+
+// COSBucketFirewall : The network access restrictions of a Cloud Object Storage bucket.
+type COSBucketFirewall struct {
+	// The IP addresses and subnets allowed to access the bucket.
+	AllowedIP []string `json:"allowed_ip,omitempty"`
+
+	// The IP addresses and subnets denied access to the bucket.
+	DeniedIP []string `json:"denied_ip,omitempty"`
+
+	// The network types allowed to access the bucket, e.g. public or private.
+	AllowedNetworkType []string `json:"allowed_network_type,omitempty"`
+}
+
+// COSBucketActivityTracking : The Activity Tracker settings of a Cloud Object Storage bucket.
+type COSBucketActivityTracking struct {
+	// Whether events are generated for read operations on objects.
+	ReadDataEvents *bool `json:"read_data_events,omitempty"`
+
+	// Whether events are generated for write operations on objects.
+	WriteDataEvents *bool `json:"write_data_events,omitempty"`
+
+	// Whether events are generated for management operations on the bucket.
+	ManagementEvents *bool `json:"management_events,omitempty"`
+}
+
+// COSBucketMetricsMonitoring : The metrics monitoring settings of a Cloud Object Storage bucket.
+type COSBucketMetricsMonitoring struct {
+	// Whether usage metrics are collected.
+	UsageMetricsEnabled *bool `json:"usage_metrics_enabled,omitempty"`
+
+	// Whether request metrics are collected.
+	RequestMetricsEnabled *bool `json:"request_metrics_enabled,omitempty"`
+}
+
+// IamAccessGroupConfig : The configuration of an IAM access group.
+type IamAccessGroupConfig struct {
+	// The ID of the access group.
+	ID *string `json:"id,omitempty"`
+
+	// The name of the access group.
+	Name *string `json:"name,omitempty"`
+
+	// The description of the access group.
+	Description *string `json:"description,omitempty"`
+
+	// The account ID of the access group.
+	AccountID *string `json:"account_id,omitempty"`
+
+	// Whether the access group is federated with an external identity provider.
+	IsFederated *bool `json:"is_federated,omitempty"`
+
+	// The creation time of the access group.
+	CreatedAt *strfmt.DateTime `json:"created_at,omitempty"`
+
+	// The IAM ID of the identity that created the access group.
+	CreatedByID *string `json:"created_by_id,omitempty"`
+
+	// The last time the access group was modified.
+	LastModifiedAt *strfmt.DateTime `json:"last_modified_at,omitempty"`
+
+	// The IAM ID of the identity that last modified the access group.
+	LastModifiedByID *string `json:"last_modified_by_id,omitempty"`
+}
+
+// IamAccountSettingsConfig : The IAM identity settings of an account.
+type IamAccountSettingsConfig struct {
+	// The ID of the account.
+	AccountID *string `json:"account_id,omitempty"`
+
+	// Whether the creation of service IDs is restricted.
+	RestrictCreateServiceID *string `json:"restrict_create_service_id,omitempty"`
+
+	// Whether the creation of platform API keys is restricted.
+	RestrictCreatePlatformApikey *string `json:"restrict_create_platform_apikey,omitempty"`
+
+	// The IP addresses and subnets from which IAM tokens can be created for the account.
+	AllowedIPAddresses *string `json:"allowed_ip_addresses,omitempty"`
+
+	// The multi-factor authentication level of the account.
+	Mfa *string `json:"mfa,omitempty"`
+
+	// The number of seconds after which a session expires.
+	SessionExpirationInSeconds *string `json:"session_expiration_in_seconds,omitempty"`
+
+	// The number of seconds of inactivity after which a session is invalidated.
+	SessionInvalidationInSeconds *string `json:"session_invalidation_in_seconds,omitempty"`
+
+	// The maximum number of concurrent sessions per identity.
+	MaxSessionsPerIdentity *string `json:"max_sessions_per_identity,omitempty"`
+}
@@ -0,0 +1,389 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configurationaggregatorv1
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+
+	common "github.com/IBM/configuration-aggregator-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Constants associated with the ValueChange.Change property.
+// The kind of change made to a value.
+const (
+	ValueChange_Change_Added    = "added"
+	ValueChange_Change_Modified = "modified"
+	ValueChange_Change_Removed  = "removed"
+)
+
+// ValueChange : A change of a single value, identified by its path within the compared object.
+type ValueChange struct {
+	// The path of the value, in the syntax accepted by Configuration.Lookup(). The path "$" denotes the whole object.
+	Path string `json:"path"`
+
+	// The kind of change.
+	Change string `json:"change"`
+
+	// The value before the change. Not set when the value was added.
+	Old interface{} `json:"old,omitempty"`
+
+	// The value after the change. Not set when the value was removed.
+	New interface{} `json:"new,omitempty"`
+}
+
+// ResourceDiff : The changes made to a resource that exists in both sets of resource configurations.
+type ResourceDiff struct {
+	// The unique CRN of the IBM Cloud resource.
+	ResourceCrn string `json:"resource_crn"`
+
+	// The name of the resource, after the change.
+	ResourceName string `json:"resource_name,omitempty"`
+
+	// The name of the service to which the resource belongs.
+	ServiceName string `json:"service_name,omitempty"`
+
+	// The changes made to the metadata of the resource, e.g. its tags, resource group or name.
+	// The collection time ("last_config_refresh_time") is not compared.
+	About []ValueChange `json:"about,omitempty"`
+
+	// The changes made to the "config" property of the resource.
+	Config []ValueChange `json:"config,omitempty"`
+
+	// The changes made to the "config_v2" property of the resource.
+	ConfigV2 []ValueChange `json:"config_v2,omitempty"`
+}
+
+// ConfigsDiff : The differences between two sets of resource configurations, e.g. two snapshots of an account.
+// Resources are matched by their CRN; all lists are sorted by CRN.
+type ConfigsDiff struct {
+	// The resources that only exist in the new set.
+	Added []Config `json:"added,omitempty"`
+
+	// The resources that only exist in the old set.
+	Removed []Config `json:"removed,omitempty"`
+
+	// The resources that exist in both sets and were changed.
+	Changed []ResourceDiff `json:"changed,omitempty"`
+
+	// The number of resources that exist in both sets and were not changed.
+	UnchangedCount int64 `json:"unchanged_count"`
+}
+
+// aboutUnorderedFields are the About properties compared regardless of the order of their elements.
+var aboutUnorderedFields = []string{"access_tags", "user_tags", "service_tags", "catalog_tags"}
+
+// DiffConfigs compares two sets of resource configurations, for example the results of two pulls of ListConfigs
+// or the configurations of two Snapshots. Resources are matched by About.ResourceCrn; resources without a CRN are
+// ignored, and if a CRN appears more than once in a set only its first occurrence is used.
+func DiffConfigs(oldConfigs []Config, newConfigs []Config) *ConfigsDiff {
+	oldByCrn, oldCrns := indexConfigsByCrn(oldConfigs)
+	newByCrn, newCrns := indexConfigsByCrn(newConfigs)
+
+	result := new(ConfigsDiff)
+	for _, crn := range oldCrns {
+		if _, found := newByCrn[crn]; !found {
+			result.Removed = append(result.Removed, *oldByCrn[crn])
+		}
+	}
+	for _, crn := range newCrns {
+		oldConfig, found := oldByCrn[crn]
+		if !found {
+			result.Added = append(result.Added, *newByCrn[crn])
+			continue
+		}
+		resourceDiff := diffResource(oldConfig, newByCrn[crn])
+		if resourceDiff == nil {
+			result.UnchangedCount++
+			continue
+		}
+		result.Changed = append(result.Changed, *resourceDiff)
+	}
+	return result
+}
+
+// DiffSnapshots compares the resource configurations of two snapshots. See DiffConfigs() for details.
+func DiffSnapshots(oldSnapshot *Snapshot, newSnapshot *Snapshot) (*ConfigsDiff, error) {
+	if oldSnapshot == nil || newSnapshot == nil {
+		err := core.SDKErrorf(nil, "both snapshots must be specified", "missing-snapshot", common.GetComponentInfo())
+		return nil, err
+	}
+	return DiffConfigs(oldSnapshot.Configs, newSnapshot.Configs), nil
+}
+
+// indexConfigsByCrn returns the configurations keyed by CRN, along with the sorted list of CRNs.
+func indexConfigsByCrn(configs []Config) (index map[string]*Config, crns []string) {
+	index = make(map[string]*Config, len(configs))
+	for i := range configs {
+		if configs[i].About == nil || configs[i].About.ResourceCrn == nil {
+			continue
+		}
+		crn := *configs[i].About.ResourceCrn
+		if _, found := index[crn]; found {
+			continue
+		}
+		index[crn] = &configs[i]
+		crns = append(crns, crn)
+	}
+	sort.Strings(crns)
+	return
+}
+
+// diffResource returns the changes made to a resource, or nil if it was not changed.
+func diffResource(oldConfig *Config, newConfig *Config) *ResourceDiff {
+	result := &ResourceDiff{
+		ResourceCrn:  *newConfig.About.ResourceCrn,
+		ResourceName: core.StringNilMapper(newConfig.About.ResourceName),
+		ServiceName:  core.StringNilMapper(newConfig.About.ServiceName),
+		About:        diffValues(nil, aboutProperties(oldConfig.About), aboutProperties(newConfig.About)),
+		Config:       diffValues(nil, configurationProperties(oldConfig.Config), configurationProperties(newConfig.Config)),
+		ConfigV2:     diffValues(nil, configurationProperties(oldConfig.ConfigV2), configurationProperties(newConfig.ConfigV2)),
+	}
+	if len(result.About) == 0 && len(result.Config) == 0 && len(result.ConfigV2) == 0 {
+		return nil
+	}
+	return result
+}
+
+// aboutProperties returns the comparable properties of the metadata of a resource.
+func aboutProperties(about *About) interface{} {
+	buffer, err := json.Marshal(about)
+	if err != nil {
+		return nil
+	}
+	var properties map[string]interface{}
+	if err = json.Unmarshal(buffer, &properties); err != nil {
+		return nil
+	}
+	delete(properties, "last_config_refresh_time")
+	for _, field := range aboutUnorderedFields {
+		if tags, ok := properties[field].([]interface{}); ok {
+			sort.Slice(tags, func(i, j int) bool {
+				return fmt.Sprint(tags[i]) < fmt.Sprint(tags[j])
+			})
+		}
+	}
+	return properties
+}
+
+// configurationProperties returns the properties of a configuration, or nil if it is not set.
+func configurationProperties(configuration *Configuration) interface{} {
+	if configuration == nil {
+		return nil
+	}
+	properties := configuration.GetProperties()
+	if properties == nil {
+		properties = map[string]interface{}{}
+	}
+	return properties
+}
+
+// diffValues returns the changes between two values. Objects are compared property by property and arrays
+// element by element; any other difference is reported as a modification of the value at the current path.
+func diffValues(path []pathSegment, oldValue interface{}, newValue interface{}) (changes []ValueChange) {
+	if oldObject, ok := asObject(oldValue); ok && oldValue != nil {
+		if newObject, ok := asObject(newValue); ok && newValue != nil {
+			return diffObjects(path, oldObject, newObject)
+		}
+	}
+	if oldArray, ok := asArray(oldValue); ok {
+		if newArray, ok := asArray(newValue); ok {
+			return diffArrays(path, oldArray, newArray)
+		}
+	}
+	if scalarsEqual(oldValue, newValue) {
+		return nil
+	}
+	return []ValueChange{{
+		Path:   formatPath(path),
+		Change: ValueChange_Change_Modified,
+		Old:    oldValue,
+		New:    newValue,
+	}}
+}
+
+func diffObjects(path []pathSegment, oldObject map[string]interface{}, newObject map[string]interface{}) (changes []ValueChange) {
+	keys := make([]string, 0, len(oldObject)+len(newObject))
+	for key := range oldObject {
+		keys = append(keys, key)
+	}
+	for key := range newObject {
+		if _, found := oldObject[key]; !found {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		childPath := append(path[:len(path):len(path)], pathSegment{key: key})
+		oldValue, inOld := oldObject[key]
+		newValue, inNew := newObject[key]
+		switch {
+		case !inOld:
+			changes = append(changes, ValueChange{Path: formatPath(childPath), Change: ValueChange_Change_Added, New: newValue})
+		case !inNew:
+			changes = append(changes, ValueChange{Path: formatPath(childPath), Change: ValueChange_Change_Removed, Old: oldValue})
+		default:
+			changes = append(changes, diffValues(childPath, oldValue, newValue)...)
+		}
+	}
+	return
+}
+
+func diffArrays(path []pathSegment, oldArray []interface{}, newArray []interface{}) (changes []ValueChange) {
+	for i := 0; i < len(oldArray) || i < len(newArray); i++ {
+		childPath := append(path[:len(path):len(path)], pathSegment{index: i, isIndex: true})
+		switch {
+		case i >= len(oldArray):
+			changes = append(changes, ValueChange{Path: formatPath(childPath), Change: ValueChange_Change_Added, New: newArray[i]})
+		case i >= len(newArray):
+			changes = append(changes, ValueChange{Path: formatPath(childPath), Change: ValueChange_Change_Removed, Old: oldArray[i]})
+		default:
+			changes = append(changes, diffValues(childPath, oldArray[i], newArray[i])...)
+		}
+	}
+	return
+}
+
+// scalarsEqual returns true if two values are equal, treating numbers of different types as equal
+// if they have the same value.
+func scalarsEqual(oldValue interface{}, newValue interface{}) bool {
+	if oldNumber, ok := toFloat64(oldValue); ok {
+		newNumber, ok := toFloat64(newValue)
+		return ok && oldNumber == newNumber
+	}
+	return reflect.DeepEqual(oldValue, newValue)
+}
+
+// toFloat64 converts a numeric value to a float64.
+func toFloat64(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	}
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(rv.Uint()), true
+	}
+	return 0, false
+}
+
+// IsEmpty returns true if no resource was added, removed or changed.
+func (diff *ConfigsDiff) IsEmpty() bool {
+	return len(diff.Added) == 0 && len(diff.Removed) == 0 && len(diff.Changed) == 0
+}
+
+// Summary returns a one-line summary of the differences, e.g. "1 added, 0 removed, 2 changed, 10 unchanged".
+func (diff *ConfigsDiff) Summary() string {
+	return fmt.Sprintf("%d added, %d removed, %d changed, %d unchanged",
+		len(diff.Added), len(diff.Removed), len(diff.Changed), diff.UnchangedCount)
+}
+
+// WriteJSON writes the differences to the writer as an indented JSON document.
+func (diff *ConfigsDiff) WriteJSON(writer io.Writer) error {
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+	err := encoder.Encode(diff)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "diff-write-error", common.GetComponentInfo())
+	}
+	return err
+}
+
+// WriteText writes the differences to the writer in a human-readable format. Each added resource is listed on a line
+// starting with "+", each removed resource on a line starting with "-", and each changed resource on a line starting
+// with "~" followed by one indented line per changed value, e.g. `config.versioning: false -> true`.
+// The last line is the summary of the differences.
+func (diff *ConfigsDiff) WriteText(writer io.Writer) error {
+	var builder strings.Builder
+	for i := range diff.Added {
+		fmt.Fprintf(&builder, "+ %s\n", describeResource(diff.Added[i].About))
+	}
+	for i := range diff.Removed {
+		fmt.Fprintf(&builder, "- %s\n", describeResource(diff.Removed[i].About))
+	}
+	for _, resourceDiff := range diff.Changed {
+		fmt.Fprintf(&builder, "~ %s (%s, %s)\n", resourceDiff.ResourceCrn, resourceDiff.ResourceName, resourceDiff.ServiceName)
+		writeValueChanges(&builder, "about", resourceDiff.About)
+		writeValueChanges(&builder, "config", resourceDiff.Config)
+		writeValueChanges(&builder, "config_v2", resourceDiff.ConfigV2)
+	}
+	fmt.Fprintln(&builder, diff.Summary())
+
+	_, err := io.WriteString(writer, builder.String())
+	if err != nil {
+		err = core.SDKErrorf(err, "", "diff-write-error", common.GetComponentInfo())
+	}
+	return err
+}
+
+// String returns the differences in the format of WriteText().
+func (diff *ConfigsDiff) String() string {
+	var builder strings.Builder
+	_ = diff.WriteText(&builder)
+	return builder.String()
+}
+
+func describeResource(about *About) string {
+	if about == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s (%s, %s)", core.StringNilMapper(about.ResourceCrn),
+		core.StringNilMapper(about.ResourceName), core.StringNilMapper(about.ServiceName))
+}
+
+func writeValueChanges(builder *strings.Builder, section string, changes []ValueChange) {
+	for _, change := range changes {
+		path := section
+		if change.Path != "$" {
+			if !strings.HasPrefix(change.Path, "[") {
+				path += "."
+			}
+			path += change.Path
+		}
+		switch change.Change {
+		case ValueChange_Change_Added:
+			fmt.Fprintf(builder, "    %s: added %s\n", path, formatDiffValue(change.New))
+		case ValueChange_Change_Removed:
+			fmt.Fprintf(builder, "    %s: removed %s\n", path, formatDiffValue(change.Old))
+		default:
+			fmt.Fprintf(builder, "    %s: %s -> %s\n", path, formatDiffValue(change.Old), formatDiffValue(change.New))
+		}
+	}
+}
+
+// formatDiffValue returns the compact JSON representation of a value.
+func formatDiffValue(value interface{}) string {
+	buffer, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(buffer)
+}
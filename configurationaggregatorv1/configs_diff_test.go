@@ -0,0 +1,125 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configurationaggregatorv1_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+
+	"github.com/IBM/configuration-aggregator-go-sdk/configurationaggregatorv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ConfigurationAggregatorV1 configuration diffs`, func() {
+	var oldConfigs []configurationaggregatorv1.Config
+	var newConfigs []configurationaggregatorv1.Config
+
+	BeforeEach(func() {
+		oldBucket := mockConfig("crn:bucket1", "cloud-object-storage", "us-south", []string{"env:dev", "team:a"})
+		oldBucket.Config.SetProperty("firewall", map[string]interface{}{"allowed_ip": []interface{}{"10.0.0.0/8"}})
+		oldBucket.Config.SetProperty("versioning", false)
+		oldBucket.Config.SetProperty("retention_days", float64(30))
+
+		newBucket := mockConfig("crn:bucket1", "cloud-object-storage", "us-south", []string{"team:a", "env:prod"})
+		newBucket.About.LastConfigRefreshTime = CreateMockDateTime("2026-01-01T12:00:00.000Z")
+		newBucket.Config.SetProperty("firewall", map[string]interface{}{"allowed_ip": []interface{}{"10.0.0.0/8", "192.168.0.0/16"}})
+		newBucket.Config.SetProperty("versioning", true)
+		newBucket.Config.SetProperty("retention_days", int64(30))
+		newBucket.ConfigV2 = new(configurationaggregatorv1.Configuration)
+
+		unchanged := mockConfig("crn:key1", "kms", "us-south", []string{"a", "b"})
+		unchangedCopy := mockConfig("crn:key1", "kms", "us-south", []string{"b", "a"})
+		unchangedCopy.About.LastConfigRefreshTime = CreateMockDateTime("2026-01-01T12:00:00.000Z")
+
+		oldConfigs = []configurationaggregatorv1.Config{
+			oldBucket,
+			unchanged,
+			mockConfig("crn:removed", "kms", "eu-de", nil),
+			{},
+		}
+		newConfigs = []configurationaggregatorv1.Config{
+			mockConfig("crn:added", "kms", "eu-de", nil),
+			unchangedCopy,
+			newBucket,
+		}
+	})
+
+	It(`Invoke DiffConfigs successfully`, func() {
+		diff := configurationaggregatorv1.DiffConfigs(oldConfigs, newConfigs)
+		Expect(diff.IsEmpty()).To(BeFalse())
+		Expect(diff.Added).To(HaveLen(1))
+		Expect(*diff.Added[0].About.ResourceCrn).To(Equal("crn:added"))
+		Expect(diff.Removed).To(HaveLen(1))
+		Expect(*diff.Removed[0].About.ResourceCrn).To(Equal("crn:removed"))
+		Expect(diff.UnchangedCount).To(Equal(int64(1)))
+		Expect(diff.Changed).To(HaveLen(1))
+
+		resourceDiff := diff.Changed[0]
+		Expect(resourceDiff.ResourceCrn).To(Equal("crn:bucket1"))
+		Expect(resourceDiff.ServiceName).To(Equal("cloud-object-storage"))
+		Expect(resourceDiff.About).To(Equal([]configurationaggregatorv1.ValueChange{
+			{Path: "user_tags[0]", Change: "modified", Old: "env:dev", New: "env:prod"},
+		}))
+		Expect(resourceDiff.Config).To(Equal([]configurationaggregatorv1.ValueChange{
+			{Path: "firewall.allowed_ip[1]", Change: "added", New: "192.168.0.0/16"},
+			{Path: "versioning", Change: "modified", Old: false, New: true},
+		}))
+		Expect(resourceDiff.ConfigV2).To(HaveLen(1))
+		Expect(resourceDiff.ConfigV2[0].Path).To(Equal("$"))
+
+		Expect(configurationaggregatorv1.DiffConfigs(newConfigs, newConfigs).IsEmpty()).To(BeTrue())
+	})
+	It(`Invoke DiffSnapshots successfully`, func() {
+		diff, err := configurationaggregatorv1.DiffSnapshots(
+			configurationaggregatorv1.NewSnapshot(time.Now(), oldConfigs),
+			configurationaggregatorv1.NewSnapshot(time.Now(), newConfigs))
+		Expect(err).To(BeNil())
+		Expect(diff.Summary()).To(Equal("1 added, 1 removed, 1 changed, 1 unchanged"))
+
+		_, err = configurationaggregatorv1.DiffSnapshots(nil, configurationaggregatorv1.NewSnapshot(time.Now(), newConfigs))
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Render a diff`, func() {
+		diff := configurationaggregatorv1.DiffConfigs(oldConfigs, newConfigs)
+
+		Expect(diff.String()).To(Equal(`+ crn:added (crn:added-name, kms)
+- crn:removed (crn:removed-name, kms)
+~ crn:bucket1 (crn:bucket1-name, cloud-object-storage)
+    about.user_tags[0]: "env:dev" -> "env:prod"
+    config.firewall.allowed_ip[1]: added "192.168.0.0/16"
+    config.versioning: false -> true
+    config_v2: null -> {}
+1 added, 1 removed, 1 changed, 1 unchanged
+`))
+
+		var buffer bytes.Buffer
+		Expect(diff.WriteJSON(&buffer)).To(Succeed())
+		var document map[string]interface{}
+		Expect(json.Unmarshal(buffer.Bytes(), &document)).To(Succeed())
+		Expect(document["unchanged_count"]).To(Equal(float64(1)))
+		Expect(document["added"]).To(HaveLen(1))
+		changed := document["changed"].([]interface{})[0].(map[string]interface{})
+		Expect(changed["resource_crn"]).To(Equal("crn:bucket1"))
+		Expect(changed["config"]).To(ContainElement(map[string]interface{}{
+			"path": "versioning", "change": "modified", "old": false, "new": true,
+		}))
+
+		Expect(configurationaggregatorv1.DiffConfigs(nil, nil).String()).To(Equal("0 added, 0 removed, 0 changed, 0 unchanged\n"))
+	})
+})
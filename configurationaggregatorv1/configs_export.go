@@ -0,0 +1,299 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configurationaggregatorv1
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"iter"
+	"strconv"
+	"strings"
+
+	common "github.com/IBM/configuration-aggregator-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"sigs.k8s.io/yaml"
+)
+
+// Constants associated with the export formats.
+const (
+	ExportFormat_Csv    = "csv"
+	ExportFormat_Ndjson = "ndjson"
+	ExportFormat_Yaml   = "yaml"
+)
+
+// defaultExportColumns are the columns of a CSV export when none are specified.
+var defaultExportColumns = []string{
+	"resource_crn",
+	"resource_name",
+	"service_name",
+	"config_type",
+	"location",
+	"account_id",
+	"resource_group_id",
+	"user_tags",
+	"last_config_refresh_time",
+}
+
+// ConfigsWriter writes resource configurations to an output in a given format. Close must be called once all the
+// configurations are written, to flush the output and complete the document; it does not close the underlying
+// io.Writer.
+type ConfigsWriter interface {
+	Write(config *Config) error
+	Close() error
+}
+
+// NewConfigsWriter returns a writer for the specified format. See the ExportFormat_* constants.
+// The columns only apply to the CSV format; see NewCSVConfigsWriter.
+func NewConfigsWriter(format string, w io.Writer, columns []ExportColumn) (writer ConfigsWriter, err error) {
+	switch strings.ToLower(format) {
+	case ExportFormat_Csv:
+		return NewCSVConfigsWriter(w, columns)
+	case ExportFormat_Ndjson:
+		return NewNDJSONConfigsWriter(w), nil
+	case ExportFormat_Yaml:
+		return NewYAMLConfigsWriter(w), nil
+	}
+	err = core.SDKErrorf(nil, fmt.Sprintf("unsupported export format '%s', supported formats are: %s, %s, %s", format, ExportFormat_Csv, ExportFormat_Ndjson, ExportFormat_Yaml), "invalid-export-format", common.GetComponentInfo())
+	return
+}
+
+// ExportConfigs writes the resource configurations of a sequence, e.g. the iterator returned by AllConfigs(), and
+// closes the writer. The configurations are written as they are produced, so they are not held in memory.
+// The export stops at the first error of the sequence; the configurations written so far are flushed.
+func ExportConfigs(seq iter.Seq2[Config, error], writer ConfigsWriter) (count int64, err error) {
+	for config, seqErr := range seq {
+		if seqErr != nil {
+			err = core.RepurposeSDKProblem(seqErr, "export-configs-error")
+			break
+		}
+		err = writer.Write(&config)
+		if err != nil {
+			break
+		}
+		count++
+	}
+	closeErr := writer.Close()
+	if err == nil {
+		err = closeErr
+	}
+	return
+}
+
+// ExportWithContext writes the remaining results of the pager and closes the writer. Pages are retrieved as the
+// export progresses. See ExportConfigs() for details.
+func (pager *ConfigsPager) ExportWithContext(ctx context.Context, writer ConfigsWriter) (count int64, err error) {
+	return ExportConfigs(pager.All(ctx), writer)
+}
+
+// Export invokes ExportWithContext() using context.Background() as the context.
+func (pager *ConfigsPager) Export(writer ConfigsWriter) (count int64, err error) {
+	return pager.ExportWithContext(context.Background(), writer)
+}
+
+// ndjsonConfigsWriter writes one JSON document per line.
+type ndjsonConfigsWriter struct {
+	encoder *json.Encoder
+}
+
+// NewNDJSONConfigsWriter returns a writer of newline-delimited JSON, with one resource configuration per line.
+func NewNDJSONConfigsWriter(w io.Writer) ConfigsWriter {
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+	return &ndjsonConfigsWriter{encoder: encoder}
+}
+
+func (writer *ndjsonConfigsWriter) Write(config *Config) error {
+	if err := writer.encoder.Encode(config); err != nil {
+		return core.SDKErrorf(err, "", "export-write-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+func (writer *ndjsonConfigsWriter) Close() error {
+	return nil
+}
+
+// yamlConfigsWriter writes a YAML sequence, one item at a time.
+type yamlConfigsWriter struct {
+	w     io.Writer
+	count int64
+}
+
+// NewYAMLConfigsWriter returns a writer of a YAML document holding the sequence of resource configurations.
+// The properties have the same names as in JSON.
+func NewYAMLConfigsWriter(w io.Writer) ConfigsWriter {
+	return &yamlConfigsWriter{w: w}
+}
+
+func (writer *yamlConfigsWriter) Write(config *Config) error {
+	// A sequence of one item, appended to the previous ones, continues the sequence.
+	data, err := yaml.Marshal([]*Config{config})
+	if err == nil {
+		_, err = writer.w.Write(data)
+	}
+	if err != nil {
+		return core.SDKErrorf(err, "", "export-write-error", common.GetComponentInfo())
+	}
+	writer.count++
+	return nil
+}
+
+func (writer *yamlConfigsWriter) Close() error {
+	if writer.count > 0 {
+		return nil
+	}
+	if _, err := io.WriteString(writer.w, "[]\n"); err != nil {
+		return core.SDKErrorf(err, "", "export-write-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// ExportColumn : A column of a CSV export.
+type ExportColumn struct {
+	// The header of the column.
+	Header string
+
+	// The value of the column, as a ConfigQuery expression. It is usually a field, e.g. "service_name" or
+	// "config.encryption.enabled", but may be any expression, e.g. "len(user_tags)".
+	Expression string
+}
+
+// NewExportColumns returns columns for the specified expressions, with the expressions as headers.
+func NewExportColumns(expressions ...string) []ExportColumn {
+	columns := make([]ExportColumn, len(expressions))
+	for i, expression := range expressions {
+		columns[i] = ExportColumn{Header: expression, Expression: expression}
+	}
+	return columns
+}
+
+// DefaultExportColumns returns the columns of a CSV export when none are specified: the CRN, name, service,
+// config type, location, account, resource group, user tags and last refresh time of the resources.
+func DefaultExportColumns() []ExportColumn {
+	return NewExportColumns(defaultExportColumns...)
+}
+
+// csvConfigsWriter writes a CSV table with one row per resource configuration.
+type csvConfigsWriter struct {
+	w           *csv.Writer
+	headers     []string
+	queries     []*ConfigQuery
+	wroteHeader bool
+}
+
+// NewCSVConfigsWriter returns a writer of a CSV table with a header row and one row per resource configuration.
+// The columns default to DefaultExportColumns(). Strings, numbers and booleans are written as is, date-times in
+// RFC 3339 format, lists of strings joined with ";", and other lists and objects in JSON. Values that do not exist
+// are written as empty cells. An error is returned if an expression cannot be compiled.
+func NewCSVConfigsWriter(w io.Writer, columns []ExportColumn) (writer ConfigsWriter, err error) {
+	if len(columns) == 0 {
+		columns = DefaultExportColumns()
+	}
+	csvWriter := &csvConfigsWriter{w: csv.NewWriter(w)}
+	for _, column := range columns {
+		var query *ConfigQuery
+		query, err = CompileConfigQuery(column.Expression)
+		if err != nil {
+			err = core.SDKErrorf(err, fmt.Sprintf("invalid export column '%s': %s", column.Header, err.Error()), "invalid-export-column", common.GetComponentInfo())
+			return
+		}
+		csvWriter.headers = append(csvWriter.headers, column.Header)
+		csvWriter.queries = append(csvWriter.queries, query)
+	}
+	return csvWriter, nil
+}
+
+func (writer *csvConfigsWriter) writeHeader() error {
+	if writer.wroteHeader {
+		return nil
+	}
+	writer.wroteHeader = true
+	return writer.w.Write(writer.headers)
+}
+
+func (writer *csvConfigsWriter) Write(config *Config) error {
+	record := make([]string, len(writer.queries))
+	for i, query := range writer.queries {
+		value, err := evaluateNode(query.root, config)
+		if err != nil {
+			return core.SDKErrorf(err, fmt.Sprintf("the column '%s' cannot be evaluated: %s", writer.headers[i], err.Error()), "export-column-error", common.GetComponentInfo())
+		}
+		record[i] = formatCell(value)
+	}
+	err := writer.writeHeader()
+	if err == nil {
+		err = writer.w.Write(record)
+	}
+	if err != nil {
+		return core.SDKErrorf(err, "", "export-write-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+func (writer *csvConfigsWriter) Close() error {
+	err := writer.writeHeader()
+	if err == nil {
+		writer.w.Flush()
+		err = writer.w.Error()
+	}
+	if err != nil {
+		return core.SDKErrorf(err, "", "export-write-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// formatCell returns the representation of a value in a CSV cell.
+func formatCell(value interface{}) string {
+	switch v := value.(type) {
+	case nil, undefined:
+		return ""
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case []string:
+		return strings.Join(v, ";")
+	}
+	if values, ok := asArray(value); ok {
+		strs := make([]string, len(values))
+		for i, item := range values {
+			s, isString := item.(string)
+			if !isString {
+				return formatJSONCell(value)
+			}
+			strs[i] = s
+		}
+		return strings.Join(strs, ";")
+	}
+	if _, ok := asObject(value); ok {
+		return formatJSONCell(value)
+	}
+	return fmt.Sprint(value)
+}
+
+func formatJSONCell(value interface{}) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(data)
+}
@@ -0,0 +1,168 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configurationaggregatorv1_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/IBM/configuration-aggregator-go-sdk/configurationaggregatorv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/yaml"
+)
+
+var _ = Describe(`ConfigurationAggregatorV1 configs export`, func() {
+	var configs []configurationaggregatorv1.Config
+
+	seqOf := func(configs []configurationaggregatorv1.Config) iter.Seq2[configurationaggregatorv1.Config, error] {
+		return func(yield func(configurationaggregatorv1.Config, error) bool) {
+			for _, config := range configs {
+				if !yield(config, nil) {
+					return
+				}
+			}
+		}
+	}
+
+	BeforeEach(func() {
+		key := mockConfig("crn:key1", "kms", "us-south", []string{"env:prod", "team:security"})
+		key.Config.SetProperty("rotation", map[string]interface{}{"enabled": true, "interval_month": float64(3)})
+		key.Config.SetProperty("aliases", []interface{}{"root", float64(1)})
+		bucket := mockConfig("crn:bucket1", "cloud-object-storage", "global", nil)
+		bucket.Config.SetProperty("name", "logs, \"archived\"")
+		configs = []configurationaggregatorv1.Config{key, bucket}
+	})
+
+	It(`Export configs to CSV successfully`, func() {
+		columns := append(configurationaggregatorv1.NewExportColumns("resource_crn", "user_tags", "config.rotation.enabled", "config.rotation.interval_month", "config.aliases", "config.rotation"),
+			configurationaggregatorv1.ExportColumn{Header: "name", Expression: "config.name"},
+			configurationaggregatorv1.ExportColumn{Header: "tag_count", Expression: "len(user_tags)"})
+		var buffer bytes.Buffer
+		writer, err := configurationaggregatorv1.NewCSVConfigsWriter(&buffer, columns)
+		Expect(err).To(BeNil())
+
+		count, err := configurationaggregatorv1.ExportConfigs(seqOf(configs), writer)
+		Expect(err).To(BeNil())
+		Expect(count).To(Equal(int64(2)))
+		Expect(buffer.String()).To(Equal(strings.Join([]string{
+			`resource_crn,user_tags,config.rotation.enabled,config.rotation.interval_month,config.aliases,config.rotation,name,tag_count`,
+			`crn:key1,env:prod;team:security,true,3,"[""root"",1]","{""enabled"":true,""interval_month"":3}",,2`,
+			`crn:bucket1,,,,,,"logs, ""archived""",0`,
+			``,
+		}, "\n")))
+
+		buffer.Reset()
+		writer, err = configurationaggregatorv1.NewConfigsWriter("CSV", &buffer, nil)
+		Expect(err).To(BeNil())
+		_, err = configurationaggregatorv1.ExportConfigs(seqOf(nil), writer)
+		Expect(err).To(BeNil())
+		Expect(buffer.String()).To(Equal("resource_crn,resource_name,service_name,config_type,location,account_id,resource_group_id,user_tags,last_config_refresh_time\n"))
+
+		_, err = configurationaggregatorv1.NewCSVConfigsWriter(&buffer, configurationaggregatorv1.NewExportColumns("config.a ==", "unknown"))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("invalid export column 'config.a =='"))
+	})
+	It(`Export configs to YAML successfully`, func() {
+		var buffer bytes.Buffer
+		writer, err := configurationaggregatorv1.NewConfigsWriter(configurationaggregatorv1.ExportFormat_Yaml, &buffer, nil)
+		Expect(err).To(BeNil())
+		count, err := configurationaggregatorv1.ExportConfigs(seqOf(configs), writer)
+		Expect(err).To(BeNil())
+		Expect(count).To(Equal(int64(2)))
+		Expect(buffer.String()).To(HavePrefix("- about:\n"))
+
+		var exported []map[string]map[string]interface{}
+		Expect(yaml.Unmarshal(buffer.Bytes(), &exported)).To(Succeed())
+		Expect(exported).To(HaveLen(2))
+		Expect(exported[1]["about"]["resource_crn"]).To(Equal("crn:bucket1"))
+		Expect(exported[0]["config"]["rotation"]).To(Equal(map[string]interface{}{"enabled": true, "interval_month": float64(3)}))
+
+		buffer.Reset()
+		writer = configurationaggregatorv1.NewYAMLConfigsWriter(&buffer)
+		_, err = configurationaggregatorv1.ExportConfigs(seqOf(nil), writer)
+		Expect(err).To(BeNil())
+		Expect(buffer.String()).To(Equal("[]\n"))
+	})
+	It(`Export configs to NDJSON from a pager successfully`, func() {
+		requestNumber := 0
+		testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(req.URL.EscapedPath()).To(Equal("/configs"))
+			res.Header().Set("Content-type", "application/json")
+			requestNumber++
+			switch requestNumber {
+			case 1:
+				res.WriteHeader(200)
+				fmt.Fprintf(res, `{"next":{"start":"1"},"configs":[%s,%s],"total_count":3,"limit":2}`, mockConfigJSON("crn1"), mockConfigJSON("crn2"))
+			case 2:
+				res.WriteHeader(200)
+				fmt.Fprintf(res, `{"configs":[%s],"total_count":3,"limit":2}`, mockConfigJSON("crn3"))
+			default:
+				res.WriteHeader(400)
+			}
+		}))
+		defer testServer.Close()
+		configurationAggregatorService, err := configurationaggregatorv1.NewConfigurationAggregatorV1(&configurationaggregatorv1.ConfigurationAggregatorV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		pager, err := configurationAggregatorService.NewConfigsPager(configurationAggregatorService.NewListConfigsOptions())
+		Expect(err).To(BeNil())
+
+		var buffer bytes.Buffer
+		count, err := pager.ExportWithContext(context.Background(), configurationaggregatorv1.NewNDJSONConfigsWriter(&buffer))
+		Expect(err).To(BeNil())
+		Expect(count).To(Equal(int64(3)))
+		Expect(requestNumber).To(Equal(2))
+
+		lines := strings.Split(strings.TrimSuffix(buffer.String(), "\n"), "\n")
+		Expect(lines).To(HaveLen(3))
+		for i, line := range lines {
+			config := new(configurationaggregatorv1.Config)
+			Expect(json.Unmarshal([]byte(line), config)).To(Succeed())
+			Expect(*config.About.ResourceCrn).To(Equal(fmt.Sprintf("crn%d", i+1)))
+		}
+	})
+	It(`Stop the export at the first error`, func() {
+		var buffer bytes.Buffer
+		writer, err := configurationaggregatorv1.NewCSVConfigsWriter(&buffer, configurationaggregatorv1.NewExportColumns("resource_crn"))
+		Expect(err).To(BeNil())
+		count, err := configurationaggregatorv1.ExportConfigs(func(yield func(configurationaggregatorv1.Config, error) bool) {
+			if yield(configs[0], nil) {
+				yield(configurationaggregatorv1.Config{}, errors.New("page error"))
+			}
+		}, writer)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("page error"))
+		Expect(count).To(Equal(int64(1)))
+		Expect(buffer.String()).To(Equal("resource_crn\ncrn:key1\n"))
+
+		_, err = configurationaggregatorv1.NewConfigsWriter("xml", &buffer, nil)
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,135 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configurationaggregatorv1
+
+import (
+	"fmt"
+	"strconv"
+
+	common "github.com/IBM/configuration-aggregator-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Matches returns true if the resource configuration satisfies the filters of the options,
+// the same way the service applies them: every filter that is set must match exactly, and the tag
+// filters must be one of the tags of the corresponding kind. Limit, Start and Headers are ignored.
+func (_options *ListConfigsOptions) Matches(config *Config) bool {
+	if _options == nil {
+		return true
+	}
+	about := config.About
+	if about == nil {
+		about = new(About)
+	}
+	return matchesFilter(_options.ConfigType, about.ConfigType) &&
+		matchesFilter(_options.ServiceName, about.ServiceName) &&
+		matchesFilter(_options.ResourceGroupID, about.ResourceGroupID) &&
+		matchesFilter(_options.Location, about.Location) &&
+		matchesFilter(_options.ResourceCrn, about.ResourceCrn) &&
+		matchesFilter(_options.SubAccount, about.AccountID) &&
+		matchesTagFilter(_options.AccessTags, about.AccessTags) &&
+		matchesTagFilter(_options.UserTags, about.UserTags) &&
+		matchesTagFilter(_options.ServiceTags, about.ServiceTags)
+}
+
+func matchesFilter(filter *string, value *string) bool {
+	return filter == nil || (value != nil && *filter == *value)
+}
+
+func matchesTagFilter(filter *string, tags []string) bool {
+	if filter == nil {
+		return true
+	}
+	for _, tag := range tags {
+		if tag == *filter {
+			return true
+		}
+	}
+	return false
+}
+
+// FilterConfigs returns the resource configurations that satisfy the filters of the options.
+// See ListConfigsOptions.Matches() for details.
+func FilterConfigs(configs []Config, listConfigsOptions *ListConfigsOptions) (result []Config) {
+	for i := range configs {
+		if listConfigsOptions.Matches(&configs[i]) {
+			result = append(result, configs[i])
+		}
+	}
+	return
+}
+
+// defaultListConfigsLimit is the page size used by ListConfigsPage when the options do not specify a limit.
+const defaultListConfigsLimit = 100
+
+// ListConfigsPage applies the filters and the pagination of the options to a list of resource configurations,
+// and returns the corresponding page in the format of the ListConfigs operation. The Start option and the
+// Next.Start property of the result are offsets into the filtered list.
+// This allows results held locally, e.g. in a Snapshot, to be consumed the same way as results from the service.
+func ListConfigsPage(configs []Config, listConfigsOptions *ListConfigsOptions) (result *ListConfigsResponse, err error) {
+	filtered := FilterConfigs(configs, listConfigsOptions)
+
+	limit := int64(defaultListConfigsLimit)
+	offset := 0
+	if listConfigsOptions != nil {
+		if listConfigsOptions.Limit != nil {
+			if *listConfigsOptions.Limit <= 0 {
+				err = core.SDKErrorf(nil, fmt.Sprintf("invalid limit: %d", *listConfigsOptions.Limit), "invalid-limit", common.GetComponentInfo())
+				return
+			}
+			limit = *listConfigsOptions.Limit
+		}
+		if listConfigsOptions.Start != nil && *listConfigsOptions.Start != "" {
+			offset, err = strconv.Atoi(*listConfigsOptions.Start)
+			if err != nil || offset < 0 {
+				err = core.SDKErrorf(err, fmt.Sprintf("invalid start: '%s'", *listConfigsOptions.Start), "invalid-start", common.GetComponentInfo())
+				return
+			}
+		}
+	}
+	if offset > len(filtered) {
+		offset = len(filtered)
+	}
+	end := offset + int(limit)
+	if end > len(filtered) || end < offset {
+		end = len(filtered)
+	}
+
+	result = &ListConfigsResponse{
+		TotalCount: core.Int64Ptr(int64(len(filtered))),
+		Limit:      core.Int64Ptr(limit),
+		Configs:    filtered[offset:end],
+	}
+	if result.Configs == nil {
+		result.Configs = []Config{}
+	}
+	if end < len(filtered) {
+		result.Next = &PaginatedNext{
+			Start: core.StringPtr(strconv.Itoa(end)),
+		}
+	}
+	if offset > 0 {
+		prev := offset - int(limit)
+		if prev < 0 {
+			prev = 0
+		}
+		result.Prev = &PaginatedPrevious{
+			Start: core.StringPtr(strconv.Itoa(prev)),
+		}
+	}
+	return
+}
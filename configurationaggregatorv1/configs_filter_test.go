@@ -0,0 +1,108 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configurationaggregatorv1_test
+
+import (
+	"github.com/IBM/configuration-aggregator-go-sdk/configurationaggregatorv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ConfigurationAggregatorV1 offline filtering`, func() {
+	var configs []configurationaggregatorv1.Config
+
+	BeforeEach(func() {
+		configs = []configurationaggregatorv1.Config{
+			mockConfig("crn1", "kms", "us-south", []string{"env:prod"}),
+			mockConfig("crn2", "kms", "eu-de", []string{"env:dev"}),
+			mockConfig("crn3", "cloud-object-storage", "us-south", nil),
+		}
+	})
+
+	It(`Invoke Matches successfully`, func() {
+		var nilOptions *configurationaggregatorv1.ListConfigsOptions
+		Expect(nilOptions.Matches(&configs[0])).To(BeTrue())
+
+		options := &configurationaggregatorv1.ListConfigsOptions{
+			ServiceName: core.StringPtr("kms"),
+			Location:    core.StringPtr("us-south"),
+			UserTags:    core.StringPtr("env:prod"),
+			Limit:       core.Int64Ptr(1),
+		}
+		Expect(options.Matches(&configs[0])).To(BeTrue())
+		Expect(options.Matches(&configs[1])).To(BeFalse())
+		Expect(options.Matches(&configs[2])).To(BeFalse())
+		Expect(options.Matches(&configurationaggregatorv1.Config{})).To(BeFalse())
+	})
+	It(`Invoke FilterConfigs successfully`, func() {
+		result := configurationaggregatorv1.FilterConfigs(configs, &configurationaggregatorv1.ListConfigsOptions{Location: core.StringPtr("us-south")})
+		Expect(len(result)).To(Equal(2))
+		Expect(*result[1].About.ResourceCrn).To(Equal("crn3"))
+
+		Expect(configurationaggregatorv1.FilterConfigs(configs, nil)).To(Equal(configs))
+	})
+	It(`Invoke ListConfigsPage successfully`, func() {
+		options := &configurationaggregatorv1.ListConfigsOptions{Limit: core.Int64Ptr(2)}
+		page, err := configurationaggregatorv1.ListConfigsPage(configs, options)
+		Expect(err).To(BeNil())
+		Expect(*page.TotalCount).To(Equal(int64(3)))
+		Expect(len(page.Configs)).To(Equal(2))
+		Expect(page.Prev).To(BeNil())
+		next, err := page.GetNextStart()
+		Expect(err).To(BeNil())
+		Expect(next).To(Equal(core.StringPtr("2")))
+
+		options.Start = next
+		page, err = configurationaggregatorv1.ListConfigsPage(configs, options)
+		Expect(err).To(BeNil())
+		Expect(len(page.Configs)).To(Equal(1))
+		Expect(*page.Configs[0].About.ResourceCrn).To(Equal("crn3"))
+		Expect(page.Next).To(BeNil())
+		Expect(page.Prev.Start).To(Equal(core.StringPtr("0")))
+
+		page, err = configurationaggregatorv1.ListConfigsPage(configs, &configurationaggregatorv1.ListConfigsOptions{ServiceName: core.StringPtr("none")})
+		Expect(err).To(BeNil())
+		Expect(page.Configs).ToNot(BeNil())
+		Expect(page.Configs).To(BeEmpty())
+	})
+	It(`Invoke ListConfigsPage with error`, func() {
+		_, err := configurationaggregatorv1.ListConfigsPage(configs, &configurationaggregatorv1.ListConfigsOptions{Limit: core.Int64Ptr(0)})
+		Expect(err).ToNot(BeNil())
+		_, err = configurationaggregatorv1.ListConfigsPage(configs, &configurationaggregatorv1.ListConfigsOptions{Start: core.StringPtr("abc")})
+		Expect(err).ToNot(BeNil())
+	})
+})
+
+// mockConfig returns a Config with the specified metadata and an empty configuration.
+func mockConfig(resourceCrn string, serviceName string, location string, userTags []string) configurationaggregatorv1.Config {
+	return configurationaggregatorv1.Config{
+		About: &configurationaggregatorv1.About{
+			AccountID:             core.StringPtr("AccountID"),
+			ConfigType:            core.StringPtr("ConfigType"),
+			ResourceCrn:           core.StringPtr(resourceCrn),
+			ResourceGroupID:       core.StringPtr("ResourceGroupID"),
+			ResourceGroupName:     core.StringPtr("ResourceGroupName"),
+			ServiceName:           core.StringPtr(serviceName),
+			ResourceName:          core.StringPtr(resourceCrn + "-name"),
+			LastConfigRefreshTime: CreateMockDateTime("2019-01-01T12:00:00.000Z"),
+			Location:              core.StringPtr(location),
+			UserTags:              userTags,
+		},
+		Config: new(configurationaggregatorv1.Configuration),
+	}
+}
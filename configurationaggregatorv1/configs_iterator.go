@@ -0,0 +1,71 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configurationaggregatorv1
+
+import (
+	"context"
+	"iter"
+
+	common "github.com/IBM/configuration-aggregator-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// AllConfigs returns an iterator over all the resource configurations matching the specified options.
+// Pages are retrieved lazily as the iteration progresses, so only one page of results is held in memory
+// at a time, and no further page is requested once the loop is exited.
+// If a page cannot be retrieved or the context is done, the error is yielded and the iteration ends.
+func (configurationAggregator *ConfigurationAggregatorV1) AllConfigs(ctx context.Context, listConfigsOptions *ListConfigsOptions) iter.Seq2[Config, error] {
+	return func(yield func(Config, error) bool) {
+		if listConfigsOptions == nil {
+			listConfigsOptions = configurationAggregator.NewListConfigsOptions()
+		}
+		pager, err := configurationAggregator.NewConfigsPager(listConfigsOptions)
+		if err != nil {
+			yield(Config{}, core.RepurposeSDKProblem(err, "pager-create-error"))
+			return
+		}
+		for item, err := range pager.All(ctx) {
+			if !yield(item, err) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over the results that have not yet been retrieved by the pager.
+// The next page is only requested once all items of the current page have been consumed.
+// If a page cannot be retrieved or the context is done, the error is yielded and the iteration ends.
+func (pager *ConfigsPager) All(ctx context.Context) iter.Seq2[Config, error] {
+	return func(yield func(Config, error) bool) {
+		for pager.HasNext() {
+			if err := ctx.Err(); err != nil {
+				yield(Config{}, core.SDKErrorf(err, "", "context-done", common.GetComponentInfo()))
+				return
+			}
+			page, err := pager.GetNextWithContext(ctx)
+			if err != nil {
+				yield(Config{}, core.RepurposeSDKProblem(err, "error-getting-next-page"))
+				return
+			}
+			for _, item := range page {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,148 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configurationaggregatorv1_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/configuration-aggregator-go-sdk/configurationaggregatorv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ConfigurationAggregatorV1 configs iterator`, func() {
+	var testServer *httptest.Server
+	var requestNumber int
+	var configurationAggregatorService *configurationaggregatorv1.ConfigurationAggregatorV1
+
+	BeforeEach(func() {
+		requestNumber = 0
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			// Verify the contents of the request
+			Expect(req.URL.EscapedPath()).To(Equal("/configs"))
+			Expect(req.Method).To(Equal("GET"))
+			Expect(req.URL.Query()["service_name"]).To(Equal([]string{"testString"}))
+
+			// Set mock response
+			res.Header().Set("Content-type", "application/json")
+			requestNumber++
+			if requestNumber == 1 {
+				Expect(req.URL.Query()["start"]).To(BeEmpty())
+				res.WriteHeader(200)
+				fmt.Fprintf(res, `{"next":{"start":"1"},"configs":[%s,%s],"total_count":3,"limit":2}`, mockConfigJSON("crn1"), mockConfigJSON("crn2"))
+			} else if requestNumber == 2 {
+				Expect(req.URL.Query()["start"]).To(Equal([]string{"1"}))
+				res.WriteHeader(200)
+				fmt.Fprintf(res, `{"configs":[%s],"total_count":3,"limit":2}`, mockConfigJSON("crn3"))
+			} else {
+				res.WriteHeader(400)
+			}
+		}))
+		var serviceErr error
+		configurationAggregatorService, serviceErr = configurationaggregatorv1.NewConfigurationAggregatorV1(&configurationaggregatorv1.ConfigurationAggregatorV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Iterate over all configs successfully`, func() {
+		listConfigsOptionsModel := configurationAggregatorService.NewListConfigsOptions().SetServiceName("testString")
+
+		var crns []string
+		for config, err := range configurationAggregatorService.AllConfigs(context.Background(), listConfigsOptionsModel) {
+			Expect(err).To(BeNil())
+			crns = append(crns, *config.About.ResourceCrn)
+		}
+		Expect(crns).To(Equal([]string{"crn1", "crn2", "crn3"}))
+		Expect(requestNumber).To(Equal(2))
+		Expect(listConfigsOptionsModel.Start).To(BeNil())
+	})
+	It(`Stop fetching pages when the loop is exited`, func() {
+		listConfigsOptionsModel := configurationAggregatorService.NewListConfigsOptions().SetServiceName("testString")
+
+		count := 0
+		for _, err := range configurationAggregatorService.AllConfigs(context.Background(), listConfigsOptionsModel) {
+			Expect(err).To(BeNil())
+			count++
+			if count == 2 {
+				break
+			}
+		}
+		Expect(count).To(Equal(2))
+		Expect(requestNumber).To(Equal(1))
+	})
+	It(`Iterate over the remaining results of a pager`, func() {
+		pager, err := configurationAggregatorService.NewConfigsPager(configurationAggregatorService.NewListConfigsOptions().SetServiceName("testString"))
+		Expect(err).To(BeNil())
+		firstPage, err := pager.GetNext()
+		Expect(err).To(BeNil())
+		Expect(len(firstPage)).To(Equal(2))
+
+		var crns []string
+		for config, err := range pager.All(context.Background()) {
+			Expect(err).To(BeNil())
+			crns = append(crns, *config.About.ResourceCrn)
+		}
+		Expect(crns).To(Equal([]string{"crn3"}))
+		Expect(pager.HasNext()).To(BeFalse())
+	})
+	It(`Yield an error when the context is cancelled`, func() {
+		ctx, cancelFunc := context.WithCancel(context.Background())
+		defer cancelFunc()
+		listConfigsOptionsModel := configurationAggregatorService.NewListConfigsOptions().SetServiceName("testString")
+
+		count := 0
+		var iterErr error
+		for _, err := range configurationAggregatorService.AllConfigs(ctx, listConfigsOptionsModel) {
+			if err != nil {
+				iterErr = err
+				continue
+			}
+			count++
+			cancelFunc()
+		}
+		Expect(iterErr).ToNot(BeNil())
+		Expect(count).To(Equal(2))
+		Expect(requestNumber).To(Equal(1))
+	})
+	It(`Yield an error when the pager cannot be created`, func() {
+		listConfigsOptionsModel := configurationAggregatorService.NewListConfigsOptions().SetStart("1")
+
+		count := 0
+		for _, err := range configurationAggregatorService.AllConfigs(context.Background(), listConfigsOptionsModel) {
+			Expect(err).ToNot(BeNil())
+			count++
+		}
+		Expect(count).To(Equal(1))
+		Expect(requestNumber).To(Equal(0))
+	})
+})
+
+// mockConfigJSON returns the JSON representation of a Config with the specified resource CRN.
+func mockConfigJSON(resourceCrn string) string {
+	return fmt.Sprintf(`{"about":{"account_id":"AccountID","config_type":"ConfigType","resource_crn":"%s","resource_group_id":"ResourceGroupID","resource_group_name":"ResourceGroupName","service_name":"ServiceName","resource_name":"ResourceName","last_config_refresh_time":"2019-01-01T12:00:00.000Z","location":"Location","type":"Type","type_id":"TypeID","access_tags":["role:admin"],"user_tags":["UserTags"],"service_tags":["ServiceTags"],"created_at":"2021-05-12T23:20:50.520Z","catalog_tags":["CatalogTags"]},"config":{},"config_v2":{}}`, resourceCrn)
+}
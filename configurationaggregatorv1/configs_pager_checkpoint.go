@@ -0,0 +1,95 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configurationaggregatorv1
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	common "github.com/IBM/configuration-aggregator-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// configsPagerCheckpointVersion is the version of the checkpoint format produced by ConfigsPager.Checkpoint().
+const configsPagerCheckpointVersion = 1
+
+// configsPagerCheckpoint is the serialized state of a ConfigsPager.
+type configsPagerCheckpoint struct {
+	Version int                 `json:"version"`
+	Options *ListConfigsOptions `json:"options"`
+	Next    *string             `json:"next,omitempty"`
+	HasNext bool                `json:"has_next"`
+}
+
+// Checkpoint returns an opaque token that captures the filters of the pager and the position of the next page
+// to be retrieved. The token can be persisted and later passed to NewConfigsPagerFromCheckpoint() to resume
+// the retrieval of results, e.g. after a restart or a failed request.
+// The token does not include the headers set on the options of the pager.
+func (pager *ConfigsPager) Checkpoint() (token string, err error) {
+	optionsCopy := *pager.options
+	optionsCopy.Start = nil
+	optionsCopy.Headers = nil
+
+	checkpoint := &configsPagerCheckpoint{
+		Version: configsPagerCheckpointVersion,
+		Options: &optionsCopy,
+		Next:    pager.pageContext.next,
+		HasNext: pager.hasNext,
+	}
+	buffer, err := json.Marshal(checkpoint)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "checkpoint-marshal-error", common.GetComponentInfo())
+		return
+	}
+	token = base64.RawURLEncoding.EncodeToString(buffer)
+	return
+}
+
+// NewConfigsPagerFromCheckpoint returns a new ConfigsPager instance that resumes the retrieval of results
+// from a token previously returned by ConfigsPager.Checkpoint().
+func (configurationAggregator *ConfigurationAggregatorV1) NewConfigsPagerFromCheckpoint(token string) (pager *ConfigsPager, err error) {
+	buffer, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "checkpoint-decode-error", common.GetComponentInfo())
+		return
+	}
+
+	checkpoint := new(configsPagerCheckpoint)
+	err = json.Unmarshal(buffer, checkpoint)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "checkpoint-unmarshal-error", common.GetComponentInfo())
+		return
+	}
+	if checkpoint.Version != configsPagerCheckpointVersion {
+		err = core.SDKErrorf(nil, fmt.Sprintf("unsupported checkpoint version: %d", checkpoint.Version), "checkpoint-version-error", common.GetComponentInfo())
+		return
+	}
+	if checkpoint.Options == nil {
+		checkpoint.Options = configurationAggregator.NewListConfigsOptions()
+	}
+	checkpoint.Options.Start = nil
+
+	pager, err = configurationAggregator.NewConfigsPager(checkpoint.Options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "pager-create-error")
+		return
+	}
+	pager.pageContext.next = checkpoint.Next
+	pager.hasNext = checkpoint.HasNext
+	return
+}
@@ -0,0 +1,152 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configurationaggregatorv1_test
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/configuration-aggregator-go-sdk/configurationaggregatorv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ConfigurationAggregatorV1 configs pager checkpoints`, func() {
+	var testServer *httptest.Server
+	var failSecondPage bool
+	var configurationAggregatorService *configurationaggregatorv1.ConfigurationAggregatorV1
+
+	BeforeEach(func() {
+		failSecondPage = false
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			// Verify the contents of the request
+			Expect(req.URL.EscapedPath()).To(Equal("/configs"))
+			Expect(req.URL.Query()["location"]).To(Equal([]string{"us-south"}))
+			Expect(req.URL.Query()["limit"]).To(Equal([]string{"1"}))
+
+			// Set mock response
+			res.Header().Set("Content-type", "application/json")
+			switch req.URL.Query().Get("start") {
+			case "":
+				res.WriteHeader(200)
+				fmt.Fprintf(res, `{"next":{"start":"1"},"configs":[%s],"total_count":2,"limit":1}`, mockConfigJSON("crn1"))
+			case "1":
+				if failSecondPage {
+					res.WriteHeader(503)
+					fmt.Fprint(res, `{"message":"service unavailable"}`)
+					return
+				}
+				res.WriteHeader(200)
+				fmt.Fprintf(res, `{"configs":[%s],"total_count":2,"limit":1}`, mockConfigJSON("crn2"))
+			default:
+				res.WriteHeader(400)
+			}
+		}))
+		var serviceErr error
+		configurationAggregatorService, serviceErr = configurationaggregatorv1.NewConfigurationAggregatorV1(&configurationaggregatorv1.ConfigurationAggregatorV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Resume a pager from a checkpoint successfully`, func() {
+		listConfigsOptionsModel := configurationAggregatorService.NewListConfigsOptions().SetLocation("us-south").SetLimit(1)
+		pager, err := configurationAggregatorService.NewConfigsPager(listConfigsOptionsModel)
+		Expect(err).To(BeNil())
+
+		firstPage, err := pager.GetNext()
+		Expect(err).To(BeNil())
+		Expect(*firstPage[0].About.ResourceCrn).To(Equal("crn1"))
+
+		token, err := pager.Checkpoint()
+		Expect(err).To(BeNil())
+		Expect(token).ToNot(BeEmpty())
+
+		resumedPager, err := configurationAggregatorService.NewConfigsPagerFromCheckpoint(token)
+		Expect(err).To(BeNil())
+		Expect(resumedPager.HasNext()).To(BeTrue())
+
+		allResults, err := resumedPager.GetAll()
+		Expect(err).To(BeNil())
+		Expect(len(allResults)).To(Equal(1))
+		Expect(*allResults[0].About.ResourceCrn).To(Equal("crn2"))
+		Expect(resumedPager.HasNext()).To(BeFalse())
+	})
+	It(`Resume a new pager from a checkpoint successfully`, func() {
+		pager, err := configurationAggregatorService.NewConfigsPager(configurationAggregatorService.NewListConfigsOptions().SetLocation("us-south").SetLimit(1))
+		Expect(err).To(BeNil())
+		token, err := pager.Checkpoint()
+		Expect(err).To(BeNil())
+
+		resumedPager, err := configurationAggregatorService.NewConfigsPagerFromCheckpoint(token)
+		Expect(err).To(BeNil())
+		allResults, err := resumedPager.GetAll()
+		Expect(err).To(BeNil())
+		Expect(len(allResults)).To(Equal(2))
+	})
+	It(`Resume an exhausted pager from a checkpoint successfully`, func() {
+		pager, err := configurationAggregatorService.NewConfigsPager(configurationAggregatorService.NewListConfigsOptions().SetLocation("us-south").SetLimit(1))
+		Expect(err).To(BeNil())
+		_, err = pager.GetAll()
+		Expect(err).To(BeNil())
+		token, err := pager.Checkpoint()
+		Expect(err).To(BeNil())
+
+		resumedPager, err := configurationAggregatorService.NewConfigsPagerFromCheckpoint(token)
+		Expect(err).To(BeNil())
+		Expect(resumedPager.HasNext()).To(BeFalse())
+	})
+	It(`Resume a pager after a failed request`, func() {
+		failSecondPage = true
+		pager, err := configurationAggregatorService.NewConfigsPager(configurationAggregatorService.NewListConfigsOptions().SetLocation("us-south").SetLimit(1))
+		Expect(err).To(BeNil())
+
+		_, err = pager.GetNext()
+		Expect(err).To(BeNil())
+		_, err = pager.GetNext()
+		Expect(err).ToNot(BeNil())
+
+		token, err := pager.Checkpoint()
+		Expect(err).To(BeNil())
+
+		failSecondPage = false
+		resumedPager, err := configurationAggregatorService.NewConfigsPagerFromCheckpoint(token)
+		Expect(err).To(BeNil())
+		allResults, err := resumedPager.GetAll()
+		Expect(err).To(BeNil())
+		Expect(len(allResults)).To(Equal(1))
+		Expect(*allResults[0].About.ResourceCrn).To(Equal("crn2"))
+	})
+	It(`Invoke NewConfigsPagerFromCheckpoint with error: Invalid token`, func() {
+		pager, err := configurationAggregatorService.NewConfigsPagerFromCheckpoint("not a token!")
+		Expect(err).ToNot(BeNil())
+		Expect(pager).To(BeNil())
+
+		pager, err = configurationAggregatorService.NewConfigsPagerFromCheckpoint(base64.RawURLEncoding.EncodeToString([]byte(`{"version":99}`)))
+		Expect(err).ToNot(BeNil())
+		Expect(pager).To(BeNil())
+	})
+})
@@ -0,0 +1,240 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configurationaggregatorv1
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"sync"
+
+	common "github.com/IBM/configuration-aggregator-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// defaultParallelListConcurrency is the number of partitions listed concurrently when
+// ParallelListConfigsOptions.MaxConcurrency is not set.
+const defaultParallelListConcurrency = 4
+
+// ParallelListConfigsOptions : The ParallelListConfigs options.
+type ParallelListConfigsOptions struct {
+	// The ListConfigs filter used to partition the query.
+	PartitionBy *string `json:"partition_by" validate:"required"`
+
+	// The values of the partition filter. One query is run per value.
+	PartitionValues []string `json:"partition_values" validate:"required"`
+
+	// The filters applied to every partition. The partition filter and Start must not be set.
+	ListConfigsOptions *ListConfigsOptions `json:"list_configs_options,omitempty"`
+
+	// The maximum number of partitions listed concurrently. Defaults to 4.
+	MaxConcurrency *int64 `json:"max_concurrency,omitempty"`
+}
+
+// Constants associated with the ParallelListConfigsOptions.PartitionBy property.
+// The ListConfigs filter used to partition the query.
+const (
+	ParallelListConfigsOptions_PartitionBy_Location        = "location"
+	ParallelListConfigsOptions_PartitionBy_ResourceGroupID = "resource_group_id"
+	ParallelListConfigsOptions_PartitionBy_ServiceName     = "service_name"
+	ParallelListConfigsOptions_PartitionBy_SubAccount      = "sub_account"
+)
+
+// NewParallelListConfigsOptions : Instantiate ParallelListConfigsOptions
+func (*ConfigurationAggregatorV1) NewParallelListConfigsOptions(partitionBy string, partitionValues []string) *ParallelListConfigsOptions {
+	return &ParallelListConfigsOptions{
+		PartitionBy:     core.StringPtr(partitionBy),
+		PartitionValues: partitionValues,
+	}
+}
+
+// SetPartitionBy : Allow user to set PartitionBy
+func (_options *ParallelListConfigsOptions) SetPartitionBy(partitionBy string) *ParallelListConfigsOptions {
+	_options.PartitionBy = core.StringPtr(partitionBy)
+	return _options
+}
+
+// SetPartitionValues : Allow user to set PartitionValues
+func (_options *ParallelListConfigsOptions) SetPartitionValues(partitionValues []string) *ParallelListConfigsOptions {
+	_options.PartitionValues = partitionValues
+	return _options
+}
+
+// SetListConfigsOptions : Allow user to set ListConfigsOptions
+func (_options *ParallelListConfigsOptions) SetListConfigsOptions(listConfigsOptions *ListConfigsOptions) *ParallelListConfigsOptions {
+	_options.ListConfigsOptions = listConfigsOptions
+	return _options
+}
+
+// SetMaxConcurrency : Allow user to set MaxConcurrency
+func (_options *ParallelListConfigsOptions) SetMaxConcurrency(maxConcurrency int64) *ParallelListConfigsOptions {
+	_options.MaxConcurrency = core.Int64Ptr(maxConcurrency)
+	return _options
+}
+
+// partitionOptions returns a copy of the ListConfigs options with the partition filter set to the specified value.
+func (_options *ParallelListConfigsOptions) partitionOptions(value string) (*ListConfigsOptions, error) {
+	var listConfigsOptions ListConfigsOptions
+	if _options.ListConfigsOptions != nil {
+		listConfigsOptions = *_options.ListConfigsOptions
+	}
+
+	var filter **string
+	switch *_options.PartitionBy {
+	case ParallelListConfigsOptions_PartitionBy_Location:
+		filter = &listConfigsOptions.Location
+	case ParallelListConfigsOptions_PartitionBy_ResourceGroupID:
+		filter = &listConfigsOptions.ResourceGroupID
+	case ParallelListConfigsOptions_PartitionBy_ServiceName:
+		filter = &listConfigsOptions.ServiceName
+	case ParallelListConfigsOptions_PartitionBy_SubAccount:
+		filter = &listConfigsOptions.SubAccount
+	default:
+		return nil, core.SDKErrorf(nil, fmt.Sprintf("unsupported partition filter: '%s'", *_options.PartitionBy), "invalid-partition", common.GetComponentInfo())
+	}
+	if *filter != nil {
+		return nil, core.SDKErrorf(nil, fmt.Sprintf("the '%s' filter should not be set on the ListConfigs options", *_options.PartitionBy), "partition-conflict", common.GetComponentInfo())
+	}
+	*filter = core.StringPtr(value)
+	return &listConfigsOptions, nil
+}
+
+// parallelListResult is an item or an error produced while listing a partition.
+type parallelListResult struct {
+	config Config
+	err    error
+}
+
+// ParallelListConfigs returns an iterator over the resource configurations of all the partitions described by
+// the specified options. The partitions are listed concurrently by a bounded pool of ConfigsPagers, and their
+// results are merged into a single stream, de-duplicated by resource CRN. The order of the results is not defined.
+// The first error is yielded and ends the iteration; exiting the loop stops all pending requests.
+func (configurationAggregator *ConfigurationAggregatorV1) ParallelListConfigs(ctx context.Context, parallelListConfigsOptions *ParallelListConfigsOptions) iter.Seq2[Config, error] {
+	return func(yield func(Config, error) bool) {
+		err := core.ValidateNotNil(parallelListConfigsOptions, "parallelListConfigsOptions cannot be nil")
+		if err != nil {
+			yield(Config{}, core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo()))
+			return
+		}
+		err = core.ValidateStruct(parallelListConfigsOptions, "parallelListConfigsOptions")
+		if err != nil {
+			yield(Config{}, core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo()))
+			return
+		}
+
+		// Build the options of every partition up-front so that invalid options are reported before any request is sent.
+		partitions := make([]*ListConfigsOptions, 0, len(parallelListConfigsOptions.PartitionValues))
+		for _, value := range parallelListConfigsOptions.PartitionValues {
+			var listConfigsOptions *ListConfigsOptions
+			listConfigsOptions, err = parallelListConfigsOptions.partitionOptions(value)
+			if err != nil {
+				yield(Config{}, err)
+				return
+			}
+			partitions = append(partitions, listConfigsOptions)
+		}
+
+		concurrency := defaultParallelListConcurrency
+		if parallelListConfigsOptions.MaxConcurrency != nil && *parallelListConfigsOptions.MaxConcurrency > 0 {
+			concurrency = int(*parallelListConfigsOptions.MaxConcurrency)
+		}
+		if concurrency > len(partitions) {
+			concurrency = len(partitions)
+		}
+
+		workerCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		queue := make(chan *ListConfigsOptions, len(partitions))
+		for _, listConfigsOptions := range partitions {
+			queue <- listConfigsOptions
+		}
+		close(queue)
+
+		results := make(chan parallelListResult)
+		var wg sync.WaitGroup
+		for i := 0; i < concurrency; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for listConfigsOptions := range queue {
+					if !configurationAggregator.listConfigsPartition(workerCtx, listConfigsOptions, results) {
+						return
+					}
+				}
+			}()
+		}
+		go func() {
+			wg.Wait()
+			close(results)
+		}()
+
+		seen := make(map[string]bool)
+		for result := range results {
+			if result.err != nil {
+				yield(Config{}, result.err)
+				return
+			}
+			if result.config.About != nil && result.config.About.ResourceCrn != nil {
+				if seen[*result.config.About.ResourceCrn] {
+					continue
+				}
+				seen[*result.config.About.ResourceCrn] = true
+			}
+			if !yield(result.config, nil) {
+				return
+			}
+		}
+
+		// A worker may have stopped without reporting its error if the context was done in the meantime.
+		if err := ctx.Err(); err != nil {
+			yield(Config{}, core.SDKErrorf(err, "", "context-done", common.GetComponentInfo()))
+		}
+	}
+}
+
+// listConfigsPartition sends all the results of a single partition to the results channel.
+// It returns false if the partition could not be listed completely.
+func (configurationAggregator *ConfigurationAggregatorV1) listConfigsPartition(ctx context.Context, listConfigsOptions *ListConfigsOptions, results chan<- parallelListResult) bool {
+	send := func(result parallelListResult) bool {
+		select {
+		case results <- result:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	pager, err := configurationAggregator.NewConfigsPager(listConfigsOptions)
+	if err != nil {
+		send(parallelListResult{err: core.RepurposeSDKProblem(err, "pager-create-error")})
+		return false
+	}
+	for pager.HasNext() {
+		page, err := pager.GetNextWithContext(ctx)
+		if err != nil {
+			send(parallelListResult{err: core.RepurposeSDKProblem(err, "error-getting-next-page")})
+			return false
+		}
+		for _, item := range page {
+			if !send(parallelListResult{config: item}) {
+				return false
+			}
+		}
+	}
+	return true
+}
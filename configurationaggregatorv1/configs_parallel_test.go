@@ -0,0 +1,169 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configurationaggregatorv1_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"github.com/IBM/configuration-aggregator-go-sdk/configurationaggregatorv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ConfigurationAggregatorV1 parallel listing`, func() {
+	var testServer *httptest.Server
+	var inFlight, maxInFlight, requestCount int32
+	var configurationAggregatorService *configurationaggregatorv1.ConfigurationAggregatorV1
+
+	BeforeEach(func() {
+		inFlight, maxInFlight, requestCount = 0, 0, 0
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			current := atomic.AddInt32(&inFlight, 1)
+			defer atomic.AddInt32(&inFlight, -1)
+			for {
+				observed := atomic.LoadInt32(&maxInFlight)
+				if current <= observed || atomic.CompareAndSwapInt32(&maxInFlight, observed, current) {
+					break
+				}
+			}
+			atomic.AddInt32(&requestCount, 1)
+			time.Sleep(10 * time.Millisecond)
+
+			// Verify the contents of the request
+			Expect(req.URL.EscapedPath()).To(Equal("/configs"))
+			Expect(req.URL.Query()["service_name"]).To(Equal([]string{"kms"}))
+
+			// Set mock response
+			res.Header().Set("Content-type", "application/json")
+			location := req.URL.Query().Get("location")
+			switch location {
+			case "us-south":
+				if req.URL.Query().Get("start") == "" {
+					res.WriteHeader(200)
+					fmt.Fprintf(res, `{"next":{"start":"1"},"configs":[%s],"limit":1}`, mockConfigJSON("crn-us-south-1"))
+				} else {
+					res.WriteHeader(200)
+					fmt.Fprintf(res, `{"configs":[%s],"limit":1}`, mockConfigJSON("crn-us-south-2"))
+				}
+			case "eu-de", "eu-gb", "jp-tok":
+				res.WriteHeader(200)
+				fmt.Fprintf(res, `{"configs":[%s,%s]}`, mockConfigJSON("crn-"+location), mockConfigJSON("crn-global"))
+			default:
+				res.WriteHeader(500)
+				fmt.Fprint(res, `{"message":"internal error"}`)
+			}
+		}))
+		var serviceErr error
+		configurationAggregatorService, serviceErr = configurationaggregatorv1.NewConfigurationAggregatorV1(&configurationaggregatorv1.ConfigurationAggregatorV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Invoke ParallelListConfigs successfully`, func() {
+		listConfigsOptionsModel := configurationAggregatorService.NewListConfigsOptions().SetServiceName("kms")
+		parallelListConfigsOptionsModel := configurationAggregatorService.NewParallelListConfigsOptions(
+			configurationaggregatorv1.ParallelListConfigsOptions_PartitionBy_Location,
+			[]string{"us-south", "eu-de", "eu-gb", "jp-tok"},
+		).SetListConfigsOptions(listConfigsOptionsModel).SetMaxConcurrency(2)
+
+		var crns []string
+		for config, err := range configurationAggregatorService.ParallelListConfigs(context.Background(), parallelListConfigsOptionsModel) {
+			Expect(err).To(BeNil())
+			crns = append(crns, *config.About.ResourceCrn)
+		}
+		sort.Strings(crns)
+		Expect(crns).To(Equal([]string{"crn-eu-de", "crn-eu-gb", "crn-global", "crn-jp-tok", "crn-us-south-1", "crn-us-south-2"}))
+		Expect(atomic.LoadInt32(&requestCount)).To(Equal(int32(5)))
+		Expect(atomic.LoadInt32(&maxInFlight)).To(BeNumerically("<=", 2))
+		Expect(listConfigsOptionsModel.Location).To(BeNil())
+	})
+	It(`Invoke ParallelListConfigs and exit the loop early`, func() {
+		parallelListConfigsOptionsModel := configurationAggregatorService.NewParallelListConfigsOptions(
+			configurationaggregatorv1.ParallelListConfigsOptions_PartitionBy_Location,
+			[]string{"us-south", "eu-de", "eu-gb", "jp-tok"},
+		).SetListConfigsOptions(configurationAggregatorService.NewListConfigsOptions().SetServiceName("kms")).SetMaxConcurrency(1)
+
+		count := 0
+		for _, err := range configurationAggregatorService.ParallelListConfigs(context.Background(), parallelListConfigsOptionsModel) {
+			Expect(err).To(BeNil())
+			count++
+			break
+		}
+		Expect(count).To(Equal(1))
+		Expect(atomic.LoadInt32(&requestCount)).To(BeNumerically("<", 5))
+	})
+	It(`Invoke ParallelListConfigs with error: Operation request error`, func() {
+		parallelListConfigsOptionsModel := configurationAggregatorService.NewParallelListConfigsOptions(
+			configurationaggregatorv1.ParallelListConfigsOptions_PartitionBy_Location,
+			[]string{"eu-de", "unknown"},
+		).SetListConfigsOptions(configurationAggregatorService.NewListConfigsOptions().SetServiceName("kms"))
+
+		var iterErr error
+		for _, err := range configurationAggregatorService.ParallelListConfigs(context.Background(), parallelListConfigsOptionsModel) {
+			if err != nil {
+				iterErr = err
+			}
+		}
+		Expect(iterErr).ToNot(BeNil())
+	})
+	It(`Invoke ParallelListConfigs with error: Invalid options`, func() {
+		expectError := func(options *configurationaggregatorv1.ParallelListConfigsOptions) {
+			count := 0
+			for _, err := range configurationAggregatorService.ParallelListConfigs(context.Background(), options) {
+				Expect(err).ToNot(BeNil())
+				count++
+			}
+			Expect(count).To(Equal(1))
+		}
+
+		expectError(nil)
+		expectError(&configurationaggregatorv1.ParallelListConfigsOptions{})
+		expectError(configurationAggregatorService.NewParallelListConfigsOptions("config_type", []string{"a"}))
+		expectError(configurationAggregatorService.NewParallelListConfigsOptions(
+			configurationaggregatorv1.ParallelListConfigsOptions_PartitionBy_ServiceName,
+			[]string{"kms"},
+		).SetListConfigsOptions(configurationAggregatorService.NewListConfigsOptions().SetServiceName("kms")))
+		Expect(atomic.LoadInt32(&requestCount)).To(Equal(int32(0)))
+	})
+	It(`Invoke NewParallelListConfigsOptions successfully`, func() {
+		listConfigsOptionsModel := configurationAggregatorService.NewListConfigsOptions()
+		parallelListConfigsOptionsModel := configurationAggregatorService.NewParallelListConfigsOptions("location", []string{"us-south"})
+		parallelListConfigsOptionsModel.SetPartitionBy("sub_account")
+		parallelListConfigsOptionsModel.SetPartitionValues([]string{"testString"})
+		parallelListConfigsOptionsModel.SetListConfigsOptions(listConfigsOptionsModel)
+		parallelListConfigsOptionsModel.SetMaxConcurrency(int64(8))
+		Expect(parallelListConfigsOptionsModel).ToNot(BeNil())
+		Expect(parallelListConfigsOptionsModel.PartitionBy).To(Equal(core.StringPtr("sub_account")))
+		Expect(parallelListConfigsOptionsModel.PartitionValues).To(Equal([]string{"testString"}))
+		Expect(parallelListConfigsOptionsModel.ListConfigsOptions).To(Equal(listConfigsOptionsModel))
+		Expect(parallelListConfigsOptionsModel.MaxConcurrency).To(Equal(core.Int64Ptr(int64(8))))
+	})
+})
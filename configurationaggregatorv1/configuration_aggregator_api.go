@@ -0,0 +1,82 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configurationaggregatorv1
+
+import (
+	"context"
+
+	common "github.com/IBM/configuration-aggregator-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// ConfigurationAggregatorAPI : The operations of the Configuration Aggregator service.
+// ConfigurationAggregatorV1 implements it; code that depends on this interface rather than on the concrete client
+// can be tested with another implementation, e.g. the in-memory mock of the configurationaggregatorv1/mock package.
+type ConfigurationAggregatorAPI interface {
+	// ListConfigs : List of configurations of the resources.
+	ListConfigs(listConfigsOptions *ListConfigsOptions) (result *ListConfigsResponse, response *core.DetailedResponse, err error)
+	ListConfigsWithContext(ctx context.Context, listConfigsOptions *ListConfigsOptions) (result *ListConfigsResponse, response *core.DetailedResponse, err error)
+
+	// ReplaceSettings : Replace the settings for Configuration Aggregator.
+	ReplaceSettings(replaceSettingsOptions *ReplaceSettingsOptions) (result *SettingsResponse, response *core.DetailedResponse, err error)
+	ReplaceSettingsWithContext(ctx context.Context, replaceSettingsOptions *ReplaceSettingsOptions) (result *SettingsResponse, response *core.DetailedResponse, err error)
+
+	// GetSettings : Retrieve the settings for Configuration Aggregator.
+	GetSettings(getSettingsOptions *GetSettingsOptions) (result *SettingsResponse, response *core.DetailedResponse, err error)
+	GetSettingsWithContext(ctx context.Context, getSettingsOptions *GetSettingsOptions) (result *SettingsResponse, response *core.DetailedResponse, err error)
+
+	// GetResourceCollectionStatus : Retrieve the status of the resource collection.
+	GetResourceCollectionStatus(getResourceCollectionStatusOptions *GetResourceCollectionStatusOptions) (result *StatusResponse, response *core.DetailedResponse, err error)
+	GetResourceCollectionStatusWithContext(ctx context.Context, getResourceCollectionStatusOptions *GetResourceCollectionStatusOptions) (result *StatusResponse, response *core.DetailedResponse, err error)
+
+	// ManualReconcile : Trigger a manual resource collection.
+	ManualReconcile(manualReconcileOptions *ManualReconcileOptions) (result *ManualReconcileResponse, response *core.DetailedResponse, err error)
+	ManualReconcileWithContext(ctx context.Context, manualReconcileOptions *ManualReconcileOptions) (result *ManualReconcileResponse, response *core.DetailedResponse, err error)
+
+	// NewConfigsPager returns a pager over the results of ListConfigs.
+	NewConfigsPager(options *ListConfigsOptions) (pager *ConfigsPager, err error)
+}
+
+var _ ConfigurationAggregatorAPI = (*ConfigurationAggregatorV1)(nil)
+
+// NewConfigsPagerWithClient returns a new ConfigsPager instance that retrieves the pages of results with the
+// ListConfigsWithContext method of the specified client, so that implementations of ConfigurationAggregatorAPI
+// other than ConfigurationAggregatorV1 can provide pagers.
+func NewConfigsPagerWithClient(client ConfigurationAggregatorAPI, options *ListConfigsOptions) (pager *ConfigsPager, err error) {
+	err = core.ValidateNotNil(client, "client cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	if options.Start != nil && *options.Start != "" {
+		err = core.SDKErrorf(nil, "the 'options.Start' field should not be set", "no-query-setting", common.GetComponentInfo())
+		return
+	}
+
+	var optionsCopy ListConfigsOptions = *options
+	pager = &ConfigsPager{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  client,
+	}
+	return
+}
@@ -27,6 +27,7 @@ import (
 	"fmt"
 	"net/http"
 	"reflect"
+	"strings"
 	"time"
 
 	common "github.com/IBM/configuration-aggregator-go-sdk/common"
@@ -40,6 +41,12 @@ import (
 // See: https://cloud.ibm.com/docs/app-configuration
 type ConfigurationAggregatorV1 struct {
 	Service *core.BaseService
+
+	// The limiter of the requests, if any.
+	requestLimiter *RequestLimiter
+
+	// The middlewares invoked around the requests, in registration order.
+	middlewares []Middleware
 }
 
 // DefaultServiceURL is the default URL to make service requests to.
@@ -55,11 +62,54 @@ var defaultUrlVariables = map[string]string{
 	"instance_id": "provide-here-your-appconfig-instance-uuid",
 }
 
+// Names of the external configuration properties used to build the service URL
+// when no explicit URL is configured (e.g. CONFIGURATION_AGGREGATOR_REGION).
+const (
+	PropNameRegion     = "REGION"
+	PropNameInstanceID = "INSTANCE_ID"
+)
+
+// serviceRegions is the list of regions in which the Configuration Aggregator service is available. The resource
+// collection can also be enabled across them, and across the collectionOnlyRegions.
+var serviceRegions = []string{
+	"au-syd",
+	"br-sao",
+	"ca-tor",
+	"eu-de",
+	"eu-es",
+	"eu-gb",
+	"jp-osa",
+	"jp-tok",
+	"us-east",
+	"us-south",
+}
+
 // ConfigurationAggregatorV1Options : Service options
 type ConfigurationAggregatorV1Options struct {
 	ServiceName   string
 	URL           string
 	Authenticator core.Authenticator
+
+	// The region of the App Configuration instance (e.g. "us-south"). Used together with InstanceID
+	// to build the service URL when URL is not set. Defaults to "us-south".
+	Region string
+
+	// The GUID of the App Configuration instance. Used together with Region
+	// to build the service URL when URL is not set.
+	InstanceID string
+
+	// The maximum sustained number of requests per second sent by the client. Zero means no rate limit.
+	RateLimit float64
+
+	// The maximum number of requests sent at once when RateLimit allows it. Defaults to 1.
+	RateLimitBurst int
+
+	// The maximum number of requests of the client in flight at any time. Zero means no limit.
+	MaxConcurrentRequests int
+
+	// A limiter of the requests, e.g. shared with other clients of the same instance. Takes precedence over
+	// RateLimit, RateLimitBurst and MaxConcurrentRequests. See RequestLimiter for details.
+	RequestLimiter *RequestLimiter
 }
 
 // NewConfigurationAggregatorV1UsingExternalConfig : constructs an instance of ConfigurationAggregatorV1 with passed in options and external configuration.
@@ -76,21 +126,63 @@ func NewConfigurationAggregatorV1UsingExternalConfig(options *ConfigurationAggre
 		}
 	}
 
-	configurationAggregator, err = NewConfigurationAggregatorV1(options)
+	// The region and instance ID may also come from external configuration, each option taking precedence over the
+	// corresponding property. The external properties are ignored when an external URL is configured, as this URL
+	// takes precedence over them.
+	serviceProps, err := core.GetServiceProperties(options.ServiceName)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "client-config-error", common.GetComponentInfo())
+		return
+	}
+	region, instanceID := options.Region, options.InstanceID
+	if serviceProps[core.PROPNAME_SVC_URL] == "" {
+		if region == "" {
+			region = serviceProps[PropNameRegion]
+		}
+		if instanceID == "" {
+			instanceID = serviceProps[PropNameInstanceID]
+		}
+	}
+	var instanceURL string
+	if options.URL == "" {
+		instanceURL, err = getServiceURLForOptions(region, instanceID)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "external-url-error")
+			return
+		}
+	}
+
+	clientOptions := *options
+	clientOptions.Region, clientOptions.InstanceID = "", ""
+	if options.URL == "" {
+		clientOptions.URL = instanceURL
+	}
+	configurationAggregator, err = NewConfigurationAggregatorV1(&clientOptions)
 	err = core.RepurposeSDKProblem(err, "new-client-error")
 	if err != nil {
 		return
 	}
 
+	// The request limiter wraps the transport of the HTTP client, which ConfigureService() may reconfigure,
+	// e.g. to disable the SSL verification, so it is installed again afterwards.
+	requestLimiter := configurationAggregator.GetRequestLimiter()
+	configurationAggregator.SetRequestLimiter(nil)
 	err = configurationAggregator.Service.ConfigureService(options.ServiceName)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "client-config-error", common.GetComponentInfo())
 		return
 	}
+	if requestLimiter != nil {
+		configurationAggregator.SetRequestLimiter(requestLimiter)
+	}
 
+	// The URL option, and the URL built from the region and instance ID options, take precedence over the external URL.
 	if options.URL != "" {
 		err = configurationAggregator.Service.SetServiceURL(options.URL)
 		err = core.RepurposeSDKProblem(err, "url-set-error")
+	} else if options.Region != "" || options.InstanceID != "" {
+		err = configurationAggregator.Service.SetServiceURL(instanceURL)
+		err = core.RepurposeSDKProblem(err, "url-set-error")
 	}
 	return
 }
@@ -108,8 +200,16 @@ func NewConfigurationAggregatorV1(options *ConfigurationAggregatorV1Options) (se
 		return
 	}
 
-	if options.URL != "" {
-		err = baseService.SetServiceURL(options.URL)
+	serviceURL := options.URL
+	if serviceURL == "" {
+		serviceURL, err = getServiceURLForOptions(options.Region, options.InstanceID)
+		if err != nil {
+			return
+		}
+	}
+
+	if serviceURL != "" {
+		err = baseService.SetServiceURL(serviceURL)
 		if err != nil {
 			err = core.SDKErrorf(err, "", "set-url-error", common.GetComponentInfo())
 			return
@@ -120,12 +220,86 @@ func NewConfigurationAggregatorV1(options *ConfigurationAggregatorV1Options) (se
 		Service: baseService,
 	}
 
+	requestLimiter := options.RequestLimiter
+	if requestLimiter == nil && (options.RateLimit > 0 || options.MaxConcurrentRequests > 0) {
+		requestLimiter = NewRequestLimiter(&RequestLimiterOptions{
+			RateLimit:             options.RateLimit,
+			Burst:                 options.RateLimitBurst,
+			MaxConcurrentRequests: options.MaxConcurrentRequests,
+		})
+	}
+	if requestLimiter != nil {
+		service.SetRequestLimiter(requestLimiter)
+	}
+
 	return
 }
 
-// GetServiceURLForRegion returns the service URL to be used for the specified region
+// GetServiceURLForRegion returns the regional endpoint to be used for the specified region.
+// The service URL of a particular instance is the regional endpoint followed by "/instances/{instance_id}";
+// use GetServiceURLForInstance to build it.
 func GetServiceURLForRegion(region string) (string, error) {
-	return "", core.SDKErrorf(nil, "service does not support regional URLs", "no-regional-support", common.GetComponentInfo())
+	if err := validateServiceRegion(region); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("https://%s.apprapp.cloud.ibm.com/apprapp/config_aggregator/v1", region), nil
+}
+
+// GetServiceURLForInstance returns the service URL of the App Configuration instance
+// with the specified ID in the specified region.
+func GetServiceURLForInstance(region string, instanceID string) (string, error) {
+	if err := validateServiceRegion(region); err != nil {
+		return "", err
+	}
+	if instanceID == "" {
+		return "", core.SDKErrorf(nil, "an instance ID must be specified", "missing-instance-id", common.GetComponentInfo())
+	}
+	url, err := ConstructServiceURL(map[string]string{
+		"region":      region,
+		"instance_id": instanceID,
+	})
+	if err != nil {
+		err = core.SDKErrorf(err, "", "construct-url-error", common.GetComponentInfo())
+	}
+	return url, err
+}
+
+// IsSupportedRegion returns true if the service is available in the specified region.
+func IsSupportedRegion(region string) bool {
+	for _, r := range serviceRegions {
+		if r == region {
+			return true
+		}
+	}
+	return false
+}
+
+// validateServiceRegion returns an error if the service is not available in the specified region.
+func validateServiceRegion(region string) error {
+	if !IsSupportedRegion(region) {
+		return core.SDKErrorf(nil, fmt.Sprintf("service URL for region '%s' not found, supported regions are: %s", region, strings.Join(serviceRegions, ", ")), "invalid-region", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// GetSupportedRegions returns the regions in which the service is available.
+func GetSupportedRegions() []string {
+	return append([]string(nil), serviceRegions...)
+}
+
+// getServiceURLForOptions builds the service URL from a region and instance ID. It returns an empty
+// string if neither was specified. An instance ID is required as soon as a region is specified,
+// so that requests are never silently sent to the placeholder instance of DefaultServiceURL.
+func getServiceURLForOptions(region string, instanceID string) (string, error) {
+	if region == "" && instanceID == "" {
+		return "", nil
+	}
+	if region == "" {
+		region = defaultUrlVariables["region"]
+	}
+	url, err := GetServiceURLForInstance(region, instanceID)
+	err = core.RepurposeSDKProblem(err, "instance-url-error")
+	return url, err
 }
 
 // Clone makes a copy of "configurationAggregator" suitable for processing requests.
@@ -259,10 +433,10 @@ func (configurationAggregator *ConfigurationAggregatorV1) ListConfigsWithContext
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = configurationAggregator.Service.Request(request, &rawResponse)
+	response, err = configurationAggregator.request("ListConfigs", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_configs", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newServiceError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -344,10 +518,10 @@ func (configurationAggregator *ConfigurationAggregatorV1) ReplaceSettingsWithCon
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = configurationAggregator.Service.Request(request, &rawResponse)
+	response, err = configurationAggregator.request("ReplaceSettings", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "replace_settings", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newServiceError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -404,10 +578,10 @@ func (configurationAggregator *ConfigurationAggregatorV1) GetSettingsWithContext
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = configurationAggregator.Service.Request(request, &rawResponse)
+	response, err = configurationAggregator.request("GetSettings", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_settings", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newServiceError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -464,10 +638,10 @@ func (configurationAggregator *ConfigurationAggregatorV1) GetResourceCollectionS
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = configurationAggregator.Service.Request(request, &rawResponse)
+	response, err = configurationAggregator.request("GetResourceCollectionStatus", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_resource_collection_status", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newServiceError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -524,10 +698,10 @@ func (configurationAggregator *ConfigurationAggregatorV1) ManualReconcileWithCon
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = configurationAggregator.Service.Request(request, &rawResponse)
+	response, err = configurationAggregator.request("ManualReconcile", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "manual_reconcile", getServiceComponentInfo())
-		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
+		err = core.SDKErrorf(newServiceError(err), "", "http-request-err", common.GetComponentInfo())
 		return
 	}
 	if rawResponse != nil {
@@ -1315,7 +1489,7 @@ func UnmarshalStatusResponse(m map[string]json.RawMessage, result interface{}) (
 type ConfigsPager struct {
 	hasNext     bool
 	options     *ListConfigsOptions
-	client      *ConfigurationAggregatorV1
+	client      ConfigurationAggregatorAPI
 	pageContext struct {
 		next *string
 	}
@@ -1323,17 +1497,8 @@ type ConfigsPager struct {
 
 // NewConfigsPager returns a new ConfigsPager instance.
 func (configurationAggregator *ConfigurationAggregatorV1) NewConfigsPager(options *ListConfigsOptions) (pager *ConfigsPager, err error) {
-	if options.Start != nil && *options.Start != "" {
-		err = core.SDKErrorf(nil, "the 'options.Start' field should not be set", "no-query-setting", common.GetComponentInfo())
-		return
-	}
-
-	var optionsCopy ListConfigsOptions = *options
-	pager = &ConfigsPager{
-		hasNext: true,
-		options: &optionsCopy,
-		client:  configurationAggregator,
-	}
+	pager, err = NewConfigsPagerWithClient(configurationAggregator, options)
+	err = core.RepurposeSDKProblem(err, "")
 	return
 }
 
@@ -63,6 +63,50 @@ var _ = Describe(`ConfigurationAggregatorV1`, func() {
 			Expect(configurationAggregatorService).To(BeNil())
 			Expect(serviceErr).ToNot(BeNil())
 		})
+		It(`Instantiate service client with region and instance ID`, func() {
+			configurationAggregatorService, serviceErr := configurationaggregatorv1.NewConfigurationAggregatorV1(&configurationaggregatorv1.ConfigurationAggregatorV1Options{
+				Authenticator: &core.NoAuthAuthenticator{},
+				Region:        "eu-gb",
+				InstanceID:    "testInstance",
+			})
+			Expect(serviceErr).To(BeNil())
+			Expect(configurationAggregatorService.GetServiceURL()).To(Equal("https://eu-gb.apprapp.cloud.ibm.com/apprapp/config_aggregator/v1/instances/testInstance"))
+		})
+		It(`Instantiate service client with instance ID and default region`, func() {
+			configurationAggregatorService, serviceErr := configurationaggregatorv1.NewConfigurationAggregatorV1(&configurationaggregatorv1.ConfigurationAggregatorV1Options{
+				Authenticator: &core.NoAuthAuthenticator{},
+				InstanceID:    "testInstance",
+			})
+			Expect(serviceErr).To(BeNil())
+			Expect(configurationAggregatorService.GetServiceURL()).To(Equal("https://us-south.apprapp.cloud.ibm.com/apprapp/config_aggregator/v1/instances/testInstance"))
+		})
+		It(`Instantiate service client with URL taking precedence over region`, func() {
+			configurationAggregatorService, serviceErr := configurationaggregatorv1.NewConfigurationAggregatorV1(&configurationaggregatorv1.ConfigurationAggregatorV1Options{
+				Authenticator: &core.NoAuthAuthenticator{},
+				URL:           "https://testService/api",
+				Region:        "eu-gb",
+				InstanceID:    "testInstance",
+			})
+			Expect(serviceErr).To(BeNil())
+			Expect(configurationAggregatorService.GetServiceURL()).To(Equal("https://testService/api"))
+		})
+		It(`Instantiate service client with error: Invalid region`, func() {
+			configurationAggregatorService, serviceErr := configurationaggregatorv1.NewConfigurationAggregatorV1(&configurationaggregatorv1.ConfigurationAggregatorV1Options{
+				Authenticator: &core.NoAuthAuthenticator{},
+				Region:        "INVALID_REGION",
+				InstanceID:    "testInstance",
+			})
+			Expect(configurationAggregatorService).To(BeNil())
+			Expect(serviceErr).ToNot(BeNil())
+		})
+		It(`Instantiate service client with error: Missing instance ID`, func() {
+			configurationAggregatorService, serviceErr := configurationaggregatorv1.NewConfigurationAggregatorV1(&configurationaggregatorv1.ConfigurationAggregatorV1Options{
+				Authenticator: &core.NoAuthAuthenticator{},
+				Region:        "eu-gb",
+			})
+			Expect(configurationAggregatorService).To(BeNil())
+			Expect(serviceErr).ToNot(BeNil())
+		})
 	})
 	Describe(`Service constructor tests using external config`, func() {
 		Context(`Using external config, construct service client instances`, func() {
@@ -118,6 +162,77 @@ var _ = Describe(`ConfigurationAggregatorV1`, func() {
 				Expect(clone.Service.Options.Authenticator).To(Equal(configurationAggregatorService.Service.Options.Authenticator))
 			})
 		})
+		Context(`Using external config, construct service client instances from region and instance ID`, func() {
+			// Map containing environment variables used in testing.
+			var testEnvironment = map[string]string{
+				"CONFIGURATION_AGGREGATOR_REGION":      "eu-de",
+				"CONFIGURATION_AGGREGATOR_INSTANCE_ID": "testInstance",
+				"CONFIGURATION_AGGREGATOR_AUTH_TYPE":   "noauth",
+			}
+
+			It(`Create service client using external region and instance ID successfully`, func() {
+				SetTestEnvironment(testEnvironment)
+				configurationAggregatorService, serviceErr := configurationaggregatorv1.NewConfigurationAggregatorV1UsingExternalConfig(&configurationaggregatorv1.ConfigurationAggregatorV1Options{})
+				ClearTestEnvironment(testEnvironment)
+				Expect(serviceErr).To(BeNil())
+				Expect(configurationAggregatorService.GetServiceURL()).To(Equal("https://eu-de.apprapp.cloud.ibm.com/apprapp/config_aggregator/v1/instances/testInstance"))
+			})
+			It(`Create service client using external config and set region and instance ID from constructor successfully`, func() {
+				SetTestEnvironment(testEnvironment)
+				configurationAggregatorService, serviceErr := configurationaggregatorv1.NewConfigurationAggregatorV1UsingExternalConfig(&configurationaggregatorv1.ConfigurationAggregatorV1Options{
+					Region:     "jp-tok",
+					InstanceID: "otherInstance",
+				})
+				ClearTestEnvironment(testEnvironment)
+				Expect(serviceErr).To(BeNil())
+				Expect(configurationAggregatorService.GetServiceURL()).To(Equal("https://jp-tok.apprapp.cloud.ibm.com/apprapp/config_aggregator/v1/instances/otherInstance"))
+			})
+			It(`Create service client using external region and instance ID from constructor successfully`, func() {
+				SetTestEnvironment(testEnvironment)
+				configurationAggregatorService, serviceErr := configurationaggregatorv1.NewConfigurationAggregatorV1UsingExternalConfig(&configurationaggregatorv1.ConfigurationAggregatorV1Options{
+					InstanceID: "otherInstance",
+				})
+				ClearTestEnvironment(testEnvironment)
+				Expect(serviceErr).To(BeNil())
+				Expect(configurationAggregatorService.GetServiceURL()).To(Equal("https://eu-de.apprapp.cloud.ibm.com/apprapp/config_aggregator/v1/instances/otherInstance"))
+			})
+			It(`Create service client using region from constructor and external instance ID successfully`, func() {
+				SetTestEnvironment(testEnvironment)
+				configurationAggregatorService, serviceErr := configurationaggregatorv1.NewConfigurationAggregatorV1UsingExternalConfig(&configurationaggregatorv1.ConfigurationAggregatorV1Options{
+					Region: "jp-tok",
+				})
+				ClearTestEnvironment(testEnvironment)
+				Expect(serviceErr).To(BeNil())
+				Expect(configurationAggregatorService.GetServiceURL()).To(Equal("https://jp-tok.apprapp.cloud.ibm.com/apprapp/config_aggregator/v1/instances/testInstance"))
+			})
+			It(`Create service client using external URL in precedence over invalid external region`, func() {
+				SetTestEnvironment(testEnvironment)
+				os.Setenv("CONFIGURATION_AGGREGATOR_URL", "https://testService/api")
+				os.Setenv("CONFIGURATION_AGGREGATOR_REGION", "bogus")
+				configurationAggregatorService, serviceErr := configurationaggregatorv1.NewConfigurationAggregatorV1UsingExternalConfig(&configurationaggregatorv1.ConfigurationAggregatorV1Options{})
+				os.Unsetenv("CONFIGURATION_AGGREGATOR_URL")
+				ClearTestEnvironment(testEnvironment)
+				Expect(serviceErr).To(BeNil())
+				Expect(configurationAggregatorService.GetServiceURL()).To(Equal("https://testService/api"))
+			})
+			It(`Create service client using external URL in precedence over external region`, func() {
+				SetTestEnvironment(testEnvironment)
+				os.Setenv("CONFIGURATION_AGGREGATOR_URL", "https://testService/api")
+				configurationAggregatorService, serviceErr := configurationaggregatorv1.NewConfigurationAggregatorV1UsingExternalConfig(&configurationaggregatorv1.ConfigurationAggregatorV1Options{})
+				os.Unsetenv("CONFIGURATION_AGGREGATOR_URL")
+				ClearTestEnvironment(testEnvironment)
+				Expect(serviceErr).To(BeNil())
+				Expect(configurationAggregatorService.GetServiceURL()).To(Equal("https://testService/api"))
+			})
+			It(`Create service client using external config with error: Invalid region`, func() {
+				SetTestEnvironment(testEnvironment)
+				os.Setenv("CONFIGURATION_AGGREGATOR_REGION", "INVALID_REGION")
+				_, serviceErr := configurationaggregatorv1.NewConfigurationAggregatorV1UsingExternalConfig(&configurationaggregatorv1.ConfigurationAggregatorV1Options{})
+				ClearTestEnvironment(testEnvironment)
+				Expect(serviceErr).ToNot(BeNil())
+				fmt.Fprintf(GinkgoWriter, "Expected error: %s\n", serviceErr.Error())
+			})
+		})
 		Context(`Using external config, construct service client instances with error: Invalid Auth`, func() {
 			// Map containing environment variables used in testing.
 			var testEnvironment = map[string]string{
@@ -160,6 +275,31 @@ var _ = Describe(`ConfigurationAggregatorV1`, func() {
 			Expect(url).To(BeEmpty())
 			Expect(err).ToNot(BeNil())
 			fmt.Fprintf(GinkgoWriter, "Expected error: %s\n", err.Error())
+
+			url, err = configurationaggregatorv1.GetServiceURLForRegion("us-east")
+			Expect(err).To(BeNil())
+			Expect(url).To(Equal("https://us-east.apprapp.cloud.ibm.com/apprapp/config_aggregator/v1"))
+		})
+		It(`GetServiceURLForInstance(region string, instanceID string)`, func() {
+			url, err := configurationaggregatorv1.GetServiceURLForInstance("ca-tor", "testInstance")
+			Expect(err).To(BeNil())
+			Expect(url).To(Equal("https://ca-tor.apprapp.cloud.ibm.com/apprapp/config_aggregator/v1/instances/testInstance"))
+
+			url, err = configurationaggregatorv1.GetServiceURLForInstance("INVALID_REGION", "testInstance")
+			Expect(url).To(BeEmpty())
+			Expect(err).ToNot(BeNil())
+
+			url, err = configurationaggregatorv1.GetServiceURLForInstance("ca-tor", "")
+			Expect(url).To(BeEmpty())
+			Expect(err).ToNot(BeNil())
+		})
+		It(`GetSupportedRegions()`, func() {
+			regions := configurationaggregatorv1.GetSupportedRegions()
+			Expect(regions).To(ContainElement("us-south"))
+			for _, region := range regions {
+				Expect(configurationaggregatorv1.IsSupportedRegion(region)).To(BeTrue())
+			}
+			Expect(configurationaggregatorv1.IsSupportedRegion("INVALID_REGION")).To(BeFalse())
 		})
 	})
 	Describe(`Parameterized URL tests`, func() {
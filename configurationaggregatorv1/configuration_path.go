@@ -0,0 +1,461 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configurationaggregatorv1
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	common "github.com/IBM/configuration-aggregator-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/go-openapi/strfmt"
+)
+
+// Configuration paths
+//
+// The path-based accessors of Configuration accept paths made of property names separated by dots,
+// array indexes in brackets and wildcards, e.g.:
+//
+//	encryption.key_protect.enabled
+//	rules[0].source
+//	rules[*].source           (every element of an array)
+//	tags.*                    (every property of an object)
+//	labels["app.kubernetes.io/name"]
+//
+// Property names containing dots, brackets or quotes must be written in quoted brackets.
+
+// pathSegment is one element of a parsed configuration path.
+type pathSegment struct {
+	key      string
+	index    int
+	isIndex  bool
+	wildcard bool
+}
+
+// String returns the representation of the segment within a path.
+func (segment pathSegment) String() string {
+	switch {
+	case segment.wildcard:
+		return "*"
+	case segment.isIndex:
+		return fmt.Sprintf("[%d]", segment.index)
+	case strings.ContainsAny(segment.key, `.[]"*`) || segment.key == "":
+		return fmt.Sprintf("[%s]", strconv.Quote(segment.key))
+	default:
+		return segment.key
+	}
+}
+
+// formatPath returns the representation of the specified segments.
+func formatPath(segments []pathSegment) string {
+	if len(segments) == 0 {
+		return "$"
+	}
+	var builder strings.Builder
+	for i, segment := range segments {
+		s := segment.String()
+		if i > 0 && !strings.HasPrefix(s, "[") {
+			builder.WriteString(".")
+		}
+		builder.WriteString(s)
+	}
+	return builder.String()
+}
+
+// parsePath parses a configuration path into its segments.
+func parsePath(path string) (segments []pathSegment, err error) {
+	invalid := func(reason string) error {
+		return core.SDKErrorf(nil, fmt.Sprintf("invalid path '%s': %s", path, reason), "invalid-path", common.GetComponentInfo())
+	}
+	if path == "" {
+		return nil, invalid("the path is empty")
+	}
+
+	i := 0
+	expectKey := true
+	for i < len(path) {
+		switch c := path[i]; {
+		case c == '[':
+			end := strings.IndexByte(path[i:], ']')
+			if strings.HasPrefix(path[i+1:], `"`) {
+				// Quoted property names may contain a closing bracket, so look for the end of the quoted string first.
+				var quoted string
+				quoted, err = strconv.QuotedPrefix(path[i+1:])
+				if err != nil {
+					return nil, invalid(fmt.Sprintf("unterminated quoted name at offset %d", i))
+				}
+				end = 1 + len(quoted)
+				if i+end >= len(path) || path[i+end] != ']' {
+					return nil, invalid(fmt.Sprintf("missing ']' at offset %d", i+end))
+				}
+				key, _ := strconv.Unquote(quoted)
+				segments = append(segments, pathSegment{key: key})
+			} else {
+				if end < 0 {
+					return nil, invalid(fmt.Sprintf("missing ']' after offset %d", i))
+				}
+				content := path[i+1 : i+end]
+				if content == "*" {
+					segments = append(segments, pathSegment{wildcard: true})
+				} else {
+					index, convErr := strconv.Atoi(content)
+					if convErr != nil || index < 0 {
+						return nil, invalid(fmt.Sprintf("'%s' is not a valid array index", content))
+					}
+					segments = append(segments, pathSegment{index: index, isIndex: true})
+				}
+			}
+			i += end + 1
+			expectKey = false
+		case c == '.':
+			if expectKey {
+				return nil, invalid(fmt.Sprintf("unexpected '.' at offset %d", i))
+			}
+			i++
+			expectKey = true
+			if i == len(path) {
+				return nil, invalid("the path ends with '.'")
+			}
+		default:
+			if !expectKey {
+				return nil, invalid(fmt.Sprintf("expected '.' or '[' at offset %d", i))
+			}
+			end := strings.IndexAny(path[i:], ".[")
+			if end < 0 {
+				end = len(path) - i
+			}
+			key := path[i : i+end]
+			if strings.ContainsAny(key, `]"`) {
+				return nil, invalid(fmt.Sprintf("unexpected character in '%s'", key))
+			}
+			if key == "*" {
+				segments = append(segments, pathSegment{wildcard: true})
+			} else {
+				segments = append(segments, pathSegment{key: key})
+			}
+			i += end
+			expectKey = false
+		}
+	}
+	return
+}
+
+// hasWildcard returns true if any of the segments is a wildcard.
+func hasWildcard(segments []pathSegment) bool {
+	for _, segment := range segments {
+		if segment.wildcard {
+			return true
+		}
+	}
+	return false
+}
+
+// asObject returns the value as a map of properties, if it is an object.
+func asObject(value interface{}) (map[string]interface{}, bool) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return v, true
+	case *Configuration:
+		if v == nil {
+			return nil, true
+		}
+		return v.GetProperties(), true
+	}
+	rv := reflect.ValueOf(value)
+	if rv.Kind() != reflect.Map || rv.Type().Key().Kind() != reflect.String {
+		return nil, false
+	}
+	object := make(map[string]interface{}, rv.Len())
+	iter := rv.MapRange()
+	for iter.Next() {
+		object[iter.Key().String()] = iter.Value().Interface()
+	}
+	return object, true
+}
+
+// asArray returns the value as a slice of elements, if it is an array.
+func asArray(value interface{}) ([]interface{}, bool) {
+	if v, ok := value.([]interface{}); ok {
+		return v, true
+	}
+	rv := reflect.ValueOf(value)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return nil, false
+	}
+	if rv.Type().Elem().Kind() == reflect.Uint8 {
+		// Byte slices are treated as scalar values.
+		return nil, false
+	}
+	array := make([]interface{}, rv.Len())
+	for i := range array {
+		array[i] = rv.Index(i).Interface()
+	}
+	return array, true
+}
+
+// describeValueType returns a human-readable name of the JSON type of the value.
+func describeValueType(value interface{}) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case string:
+		return "a string"
+	case bool:
+		return "a boolean"
+	case float32, float64, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, json.Number:
+		return "a number"
+	}
+	if _, ok := asObject(value); ok {
+		return "an object"
+	}
+	if _, ok := asArray(value); ok {
+		return "an array"
+	}
+	return fmt.Sprintf("a %T", value)
+}
+
+// lookupPath resolves a path without wildcards. It returns found=false if a property or an array element
+// along the path does not exist, and an error if an intermediate value does not have the expected type.
+func lookupPath(root interface{}, segments []pathSegment) (value interface{}, found bool, err error) {
+	value = root
+	for i, segment := range segments {
+		if segment.isIndex {
+			array, ok := asArray(value)
+			if !ok {
+				err = fmt.Errorf("the value at '%s' is %s, not an array", formatPath(segments[:i]), describeValueType(value))
+				return nil, false, err
+			}
+			if segment.index >= len(array) {
+				return nil, false, nil
+			}
+			value = array[segment.index]
+		} else {
+			object, ok := asObject(value)
+			if !ok {
+				err = fmt.Errorf("the value at '%s' is %s, not an object", formatPath(segments[:i]), describeValueType(value))
+				return nil, false, err
+			}
+			value, ok = object[segment.key]
+			if !ok {
+				return nil, false, nil
+			}
+		}
+	}
+	return value, true, nil
+}
+
+// collectPath resolves a path that may contain wildcards and returns all the matching values.
+// Branches that do not match the path are skipped.
+func collectPath(value interface{}, segments []pathSegment) (values []interface{}) {
+	if len(segments) == 0 {
+		return []interface{}{value}
+	}
+	segment, rest := segments[0], segments[1:]
+	switch {
+	case segment.wildcard:
+		if array, ok := asArray(value); ok {
+			for _, element := range array {
+				values = append(values, collectPath(element, rest)...)
+			}
+		} else if object, ok := asObject(value); ok {
+			keys := make([]string, 0, len(object))
+			for key := range object {
+				keys = append(keys, key)
+			}
+			// Iterate in a deterministic order.
+			sort.Strings(keys)
+			for _, key := range keys {
+				values = append(values, collectPath(object[key], rest)...)
+			}
+		}
+	case segment.isIndex:
+		if array, ok := asArray(value); ok && segment.index < len(array) {
+			values = collectPath(array[segment.index], rest)
+		}
+	default:
+		if object, ok := asObject(value); ok {
+			if element, found := object[segment.key]; found {
+				values = collectPath(element, rest)
+			}
+		}
+	}
+	return
+}
+
+// Lookup returns the value at the specified path, e.g. "encryption.key_protect.enabled" or "rules[0].source".
+// An error is returned if the path is invalid, contains wildcards, or does not exist.
+func (o *Configuration) Lookup(path string) (value interface{}, err error) {
+	segments, err := parsePath(path)
+	if err != nil {
+		return
+	}
+	if hasWildcard(segments) {
+		err = core.SDKErrorf(nil, fmt.Sprintf("the path '%s' contains wildcards, use LookupAll() instead", path), "path-wildcard", common.GetComponentInfo())
+		return
+	}
+	value, found, err := lookupPath(o, segments)
+	if err != nil {
+		err = core.SDKErrorf(nil, fmt.Sprintf("cannot resolve path '%s': %s", path, err.Error()), "path-type-mismatch", common.GetComponentInfo())
+		return
+	}
+	if !found {
+		err = core.SDKErrorf(nil, fmt.Sprintf("the path '%s' does not exist", path), "path-not-found", common.GetComponentInfo())
+	}
+	return
+}
+
+// LookupAll returns all the values matching the specified path, which may contain wildcards,
+// e.g. "rules[*].source" or "tags.*". An empty result is returned if nothing matches.
+// An error is returned only if the path is invalid.
+func (o *Configuration) LookupAll(path string) (values []interface{}, err error) {
+	segments, err := parsePath(path)
+	if err != nil {
+		return
+	}
+	values = collectPath(o, segments)
+	return
+}
+
+// Has returns true if a value exists at the specified path.
+func (o *Configuration) Has(path string) bool {
+	_, err := o.Lookup(path)
+	return err == nil
+}
+
+// typeMismatchError returns the error reported by the typed getters when a value does not have the expected type.
+func typeMismatchError(path string, value interface{}, expected string) error {
+	return core.SDKErrorf(nil, fmt.Sprintf("the value at '%s' is %s, not %s", path, describeValueType(value), expected), "path-type-mismatch", common.GetComponentInfo())
+}
+
+// GetString returns the string at the specified path.
+func (o *Configuration) GetString(path string) (string, error) {
+	value, err := o.Lookup(path)
+	if err != nil {
+		return "", err
+	}
+	s, ok := value.(string)
+	if !ok {
+		return "", typeMismatchError(path, value, "a string")
+	}
+	return s, nil
+}
+
+// GetBool returns the boolean at the specified path.
+func (o *Configuration) GetBool(path string) (bool, error) {
+	value, err := o.Lookup(path)
+	if err != nil {
+		return false, err
+	}
+	b, ok := value.(bool)
+	if !ok {
+		return false, typeMismatchError(path, value, "a boolean")
+	}
+	return b, nil
+}
+
+// GetInt64 returns the integer at the specified path.
+// Numbers with a fractional part or outside the range of int64 are reported as a type mismatch.
+func (o *Configuration) GetInt64(path string) (int64, error) {
+	value, err := o.Lookup(path)
+	if err != nil {
+		return 0, err
+	}
+	i, ok := toInt64(value)
+	if !ok {
+		return 0, typeMismatchError(path, value, "an integer")
+	}
+	return i, nil
+}
+
+// GetTime returns the date-time at the specified path. The value must be a string in RFC 3339 format.
+func (o *Configuration) GetTime(path string) (time.Time, error) {
+	value, err := o.Lookup(path)
+	if err != nil {
+		return time.Time{}, err
+	}
+	t, ok := toTime(value)
+	if !ok {
+		return time.Time{}, typeMismatchError(path, value, "a date-time")
+	}
+	return t, nil
+}
+
+// toInt64 converts a numeric value to an int64, if it is integral.
+func toInt64(value interface{}) (int64, bool) {
+	switch v := value.(type) {
+	case int:
+		return int64(v), true
+	case int8:
+		return int64(v), true
+	case int16:
+		return int64(v), true
+	case int32:
+		return int64(v), true
+	case int64:
+		return v, true
+	case uint8:
+		return int64(v), true
+	case uint16:
+		return int64(v), true
+	case uint32:
+		return int64(v), true
+	case uint:
+		if uint64(v) <= math.MaxInt64 {
+			return int64(v), true
+		}
+	case uint64:
+		if v <= math.MaxInt64 {
+			return int64(v), true
+		}
+	case json.Number:
+		i, err := v.Int64()
+		return i, err == nil
+	case float32:
+		return toInt64(float64(v))
+	case float64:
+		if v == math.Trunc(v) && v >= math.MinInt64 && v < math.MaxInt64 {
+			return int64(v), true
+		}
+	}
+	return 0, false
+}
+
+// toTime converts a date-time value, or a string in RFC 3339 format, to a time.Time.
+func toTime(value interface{}) (time.Time, bool) {
+	switch v := value.(type) {
+	case time.Time:
+		return v, true
+	case strfmt.DateTime:
+		return time.Time(v), true
+	case *strfmt.DateTime:
+		if v != nil {
+			return time.Time(*v), true
+		}
+	case string:
+		dateTime, err := core.ParseDateTime(v)
+		if err == nil {
+			return time.Time(dateTime), true
+		}
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,171 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configurationaggregatorv1_test
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/IBM/configuration-aggregator-go-sdk/configurationaggregatorv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ConfigurationAggregatorV1 configuration paths`, func() {
+	var configurationModel *configurationaggregatorv1.Configuration
+
+	BeforeEach(func() {
+		raw := make(map[string]json.RawMessage)
+		err := json.Unmarshal([]byte(`{
+			"name": "bucket1",
+			"encryption": {"key_protect": {"enabled": true, "key_crn": "crn:v1:key"}},
+			"rules": [{"source": "10.0.0.0/8", "port": 443}, {"source": "0.0.0.0/0", "port": 22.5}],
+			"labels": {"app.kubernetes.io/name": "web", "tier": "frontend"},
+			"created_at": "2024-01-01T12:00:00.000Z",
+			"empty": null
+		}`), &raw)
+		Expect(err).To(BeNil())
+		err = configurationaggregatorv1.UnmarshalConfiguration(raw, &configurationModel)
+		Expect(err).To(BeNil())
+	})
+
+	It(`Invoke Lookup successfully`, func() {
+		value, err := configurationModel.Lookup("encryption.key_protect.enabled")
+		Expect(err).To(BeNil())
+		Expect(value).To(Equal(true))
+
+		value, err = configurationModel.Lookup("rules[1].source")
+		Expect(err).To(BeNil())
+		Expect(value).To(Equal("0.0.0.0/0"))
+
+		value, err = configurationModel.Lookup(`labels["app.kubernetes.io/name"]`)
+		Expect(err).To(BeNil())
+		Expect(value).To(Equal("web"))
+
+		value, err = configurationModel.Lookup("empty")
+		Expect(err).To(BeNil())
+		Expect(value).To(BeNil())
+
+		Expect(configurationModel.Has("encryption.key_protect")).To(BeTrue())
+		Expect(configurationModel.Has("encryption.cos")).To(BeFalse())
+	})
+	It(`Invoke Lookup with error`, func() {
+		_, err := configurationModel.Lookup("encryption.hpcs.enabled")
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("does not exist"))
+
+		_, err = configurationModel.Lookup("rules[5]")
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("does not exist"))
+
+		_, err = configurationModel.Lookup("name.first")
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("the value at 'name' is a string, not an object"))
+
+		_, err = configurationModel.Lookup("encryption[0]")
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("the value at 'encryption' is an object, not an array"))
+
+		_, err = configurationModel.Lookup("rules[*].source")
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("LookupAll"))
+
+		for _, path := range []string{"", ".name", "name.", "rules[", "rules[x]", "rules[-1]", `labels["tier]`, "rules[0]source", `na"me`} {
+			_, err = configurationModel.Lookup(path)
+			Expect(err).ToNot(BeNil(), path)
+			Expect(err.Error()).To(ContainSubstring("invalid path"), path)
+		}
+	})
+	It(`Invoke LookupAll successfully`, func() {
+		values, err := configurationModel.LookupAll("rules[*].source")
+		Expect(err).To(BeNil())
+		Expect(values).To(Equal([]interface{}{"10.0.0.0/8", "0.0.0.0/0"}))
+
+		values, err = configurationModel.LookupAll("labels.*")
+		Expect(err).To(BeNil())
+		Expect(values).To(Equal([]interface{}{"web", "frontend"}))
+
+		values, err = configurationModel.LookupAll("*.key_protect.enabled")
+		Expect(err).To(BeNil())
+		Expect(values).To(Equal([]interface{}{true}))
+
+		values, err = configurationModel.LookupAll("rules[*].missing")
+		Expect(err).To(BeNil())
+		Expect(values).To(BeEmpty())
+
+		_, err = configurationModel.LookupAll("rules[")
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Invoke typed getters successfully`, func() {
+		s, err := configurationModel.GetString("encryption.key_protect.key_crn")
+		Expect(err).To(BeNil())
+		Expect(s).To(Equal("crn:v1:key"))
+
+		b, err := configurationModel.GetBool("encryption.key_protect.enabled")
+		Expect(err).To(BeNil())
+		Expect(b).To(BeTrue())
+
+		i, err := configurationModel.GetInt64("rules[0].port")
+		Expect(err).To(BeNil())
+		Expect(i).To(Equal(int64(443)))
+
+		t, err := configurationModel.GetTime("created_at")
+		Expect(err).To(BeNil())
+		Expect(t.Equal(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))).To(BeTrue())
+	})
+	It(`Invoke typed getters with error`, func() {
+		_, err := configurationModel.GetString("rules[0].port")
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("the value at 'rules[0].port' is a number, not a string"))
+
+		_, err = configurationModel.GetBool("name")
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("not a boolean"))
+
+		_, err = configurationModel.GetInt64("rules[1].port")
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("not an integer"))
+
+		_, err = configurationModel.GetTime("name")
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("not a date-time"))
+
+		_, err = configurationModel.GetString("empty")
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("is null"))
+
+		_, err = configurationModel.GetString("missing")
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("does not exist"))
+	})
+	It(`Resolve paths through properties set programmatically`, func() {
+		configurationModel = new(configurationaggregatorv1.Configuration)
+		configurationModel.SetProperty("ports", []int{80, 443})
+		configurationModel.SetProperty("limits", map[string]int64{"cpu": 2})
+
+		i, err := configurationModel.GetInt64("ports[1]")
+		Expect(err).To(BeNil())
+		Expect(i).To(Equal(int64(443)))
+
+		i, err = configurationModel.GetInt64("limits.cpu")
+		Expect(err).To(BeNil())
+		Expect(i).To(Equal(int64(2)))
+
+		var nilConfiguration *configurationaggregatorv1.Configuration
+		Expect(nilConfiguration.Has("name")).To(BeFalse())
+	})
+})
@@ -0,0 +1,264 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configurationaggregatorv1
+
+import (
+	"fmt"
+	"strings"
+
+	common "github.com/IBM/configuration-aggregator-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// A Cloud Resource Name (CRN) has the following segments, separated by colons:
+//
+//	crn:version:cname:ctype:service-name:location:scope:service-instance:resource-type:resource
+//
+// For example: crn:v1:bluemix:public:cloud-object-storage:global:a/0123456789abcdef:0ba4dba0-a120-4ee5-9f77:bucket:my-bucket
+// See https://cloud.ibm.com/docs/account?topic=account-crn for details.
+
+// crnSegmentCount is the number of segments of a CRN.
+const crnSegmentCount = 10
+
+// Constants associated with the scope type of a CRN.
+const (
+	CRN_ScopeType_Account      = "a"
+	CRN_ScopeType_Organization = "o"
+	CRN_ScopeType_Space        = "s"
+	CRN_ScopeType_Project      = "p"
+)
+
+// CRN : A parsed Cloud Resource Name.
+type CRN struct {
+	// The version of the CRN format, e.g. "v1".
+	Version string `json:"version"`
+
+	// The name of the cloud instance, e.g. "bluemix".
+	CName string `json:"cname"`
+
+	// The type of the cloud instance, e.g. "public".
+	CType string `json:"ctype"`
+
+	// The name of the service, e.g. "cloud-object-storage".
+	ServiceName string `json:"service_name"`
+
+	// The location of the resource, e.g. "us-south" or "global". Empty for global resources of some services.
+	Location string `json:"location"`
+
+	// The scope of the resource, e.g. "a/<account ID>". Empty if the resource is not scoped.
+	Scope string `json:"scope"`
+
+	// The ID of the service instance. Empty if the resource is the service instance itself.
+	ServiceInstance string `json:"service_instance"`
+
+	// The type of the resource within the service instance, e.g. "bucket".
+	ResourceType string `json:"resource_type"`
+
+	// The ID of the resource within the service instance.
+	Resource string `json:"resource"`
+}
+
+// ParseCRN parses a CRN. The CRN must start with "crn:" and have 10 segments; the version, cname, ctype and service
+// name segments must not be empty, and the scope must be empty or of the form "<type>/<ID>" with a type of "a", "o",
+// "s" or "p". The resource segment is the last one, so it may contain colons.
+func ParseCRN(crn string) (result *CRN, err error) {
+	segments := strings.SplitN(crn, ":", crnSegmentCount)
+	if len(segments) != crnSegmentCount {
+		err = core.SDKErrorf(nil, fmt.Sprintf("invalid CRN '%s': expected %d segments, found %d", crn, crnSegmentCount, len(segments)), "invalid-crn", common.GetComponentInfo())
+		return
+	}
+	if segments[0] != "crn" {
+		err = core.SDKErrorf(nil, fmt.Sprintf("invalid CRN '%s': it must start with 'crn:'", crn), "invalid-crn", common.GetComponentInfo())
+		return
+	}
+	result = &CRN{
+		Version:         segments[1],
+		CName:           segments[2],
+		CType:           segments[3],
+		ServiceName:     segments[4],
+		Location:        segments[5],
+		Scope:           segments[6],
+		ServiceInstance: segments[7],
+		ResourceType:    segments[8],
+		Resource:        segments[9],
+	}
+	for _, required := range []struct{ name, value string }{
+		{"version", result.Version},
+		{"cname", result.CName},
+		{"ctype", result.CType},
+		{"service name", result.ServiceName},
+	} {
+		if required.value == "" {
+			err = core.SDKErrorf(nil, fmt.Sprintf("invalid CRN '%s': the %s segment must not be empty", crn, required.name), "invalid-crn", common.GetComponentInfo())
+			return nil, err
+		}
+	}
+	if result.Scope != "" {
+		scopeType, scopeID, found := strings.Cut(result.Scope, "/")
+		if !found || scopeID == "" || !containsString([]string{CRN_ScopeType_Account, CRN_ScopeType_Organization, CRN_ScopeType_Space, CRN_ScopeType_Project}, scopeType) {
+			err = core.SDKErrorf(nil, fmt.Sprintf("invalid CRN '%s': the scope '%s' must be of the form <type>/<ID> with a type of a, o, s or p", crn, result.Scope), "invalid-crn", common.GetComponentInfo())
+			return nil, err
+		}
+	}
+	return
+}
+
+// String returns the string representation of the CRN.
+func (crn *CRN) String() string {
+	return strings.Join(crn.segments(), ":")
+}
+
+func (crn *CRN) segments() []string {
+	return []string{"crn", crn.Version, crn.CName, crn.CType, crn.ServiceName, crn.Location, crn.Scope, crn.ServiceInstance, crn.ResourceType, crn.Resource}
+}
+
+// ScopeType returns the type of the scope, e.g. "a" for an account, or "" if the resource is not scoped.
+func (crn *CRN) ScopeType() string {
+	scopeType, _, _ := strings.Cut(crn.Scope, "/")
+	return scopeType
+}
+
+// ScopeID returns the ID of the scope, e.g. the account ID, or "" if the resource is not scoped.
+func (crn *CRN) ScopeID() string {
+	_, scopeID, _ := strings.Cut(crn.Scope, "/")
+	return scopeID
+}
+
+// AccountID returns the ID of the account of the resource, or "" if the scope is not an account.
+func (crn *CRN) AccountID() string {
+	if crn.ScopeType() != CRN_ScopeType_Account {
+		return ""
+	}
+	return crn.ScopeID()
+}
+
+// IsServiceInstance returns true if the CRN identifies a service instance rather than a resource within one.
+func (crn *CRN) IsServiceInstance() bool {
+	return crn.ResourceType == "" && crn.Resource == ""
+}
+
+// CRN parses the CRN of the resource.
+func (o *About) CRN() (*CRN, error) {
+	if o == nil || o.ResourceCrn == nil {
+		return nil, core.SDKErrorf(nil, "the resource has no CRN", "missing-crn", common.GetComponentInfo())
+	}
+	crn, err := ParseCRN(*o.ResourceCrn)
+	err = core.RepurposeSDKProblem(err, "about-crn-error")
+	return crn, err
+}
+
+// CRNPattern : A pattern that matches CRNs segment by segment. A pattern has the syntax of a CRN, but may have fewer
+// segments, in which case the missing segments match any value. A segment of "*" matches any value, and a segment
+// ending with "*" matches any value with the same prefix. For example:
+//
+//	crn:v1:bluemix:public:cloud-object-storage               (every Cloud Object Storage resource)
+//	crn:v1:*:*:kms:us-south:a/0123456789abcdef               (every Key Protect resource of an account in us-south)
+//	crn:v1:bluemix:public:cloud-object-storage:*:*:*:bucket:prod-*
+type CRNPattern struct {
+	segments []string
+}
+
+// ParseCRNPattern parses a CRN pattern.
+func ParseCRNPattern(pattern string) (result *CRNPattern, err error) {
+	segments := strings.SplitN(pattern, ":", crnSegmentCount)
+	if segments[0] != "crn" {
+		err = core.SDKErrorf(nil, fmt.Sprintf("invalid CRN pattern '%s': it must start with 'crn:'", pattern), "invalid-crn-pattern", common.GetComponentInfo())
+		return
+	}
+	for i, segment := range segments {
+		if strings.Contains(strings.TrimSuffix(segment, "*"), "*") {
+			err = core.SDKErrorf(nil, fmt.Sprintf("invalid CRN pattern '%s': '*' is only allowed at the end of segment %d", pattern, i), "invalid-crn-pattern", common.GetComponentInfo())
+			return
+		}
+	}
+	result = &CRNPattern{segments: segments}
+	return
+}
+
+// String returns the string representation of the pattern.
+func (pattern *CRNPattern) String() string {
+	return strings.Join(pattern.segments, ":")
+}
+
+// MatchesCRN returns true if the CRN matches the pattern.
+func (pattern *CRNPattern) MatchesCRN(crn *CRN) bool {
+	segments := crn.segments()
+	for i, segmentPattern := range pattern.segments {
+		if prefix, isPrefix := strings.CutSuffix(segmentPattern, "*"); isPrefix {
+			if !strings.HasPrefix(segments[i], prefix) {
+				return false
+			}
+		} else if segments[i] != segmentPattern {
+			return false
+		}
+	}
+	return true
+}
+
+// Matches returns true if the string is a valid CRN that matches the pattern.
+func (pattern *CRNPattern) Matches(crn string) bool {
+	parsed, err := ParseCRN(crn)
+	return err == nil && pattern.MatchesCRN(parsed)
+}
+
+// MatchesConfig returns true if the CRN of the resource configuration matches the pattern.
+func (pattern *CRNPattern) MatchesConfig(config *Config) bool {
+	return config.About != nil && config.About.ResourceCrn != nil && pattern.Matches(*config.About.ResourceCrn)
+}
+
+// FilterConfigs returns the resource configurations whose CRN matches the pattern.
+func (pattern *CRNPattern) FilterConfigs(configs []Config) (result []Config) {
+	for i := range configs {
+		if pattern.MatchesConfig(&configs[i]) {
+			result = append(result, configs[i])
+		}
+	}
+	return
+}
+
+// literalSegment returns the value of a segment of the pattern that matches a single value.
+func (pattern *CRNPattern) literalSegment(i int) (string, bool) {
+	if i >= len(pattern.segments) || strings.HasSuffix(pattern.segments[i], "*") {
+		return "", false
+	}
+	return pattern.segments[i], true
+}
+
+// SetResourceCRN sets the ResourceCrn filter to the specified CRN.
+func (_options *ListConfigsOptions) SetResourceCRN(crn *CRN) *ListConfigsOptions {
+	_options.ResourceCrn = core.StringPtr(crn.String())
+	return _options
+}
+
+// SetCRNPattern sets the filters that the service can apply for the specified pattern: the ServiceName and
+// Location filters if the corresponding segments of the pattern match a single non-empty value, and the ResourceCrn
+// filter if the pattern matches a single CRN. The service cannot apply the other segments of the pattern, so the
+// results must still be filtered with CRNPattern.MatchesConfig() or CRNPattern.FilterConfigs().
+func (_options *ListConfigsOptions) SetCRNPattern(pattern *CRNPattern) *ListConfigsOptions {
+	if serviceName, ok := pattern.literalSegment(4); ok && serviceName != "" {
+		_options.ServiceName = core.StringPtr(serviceName)
+	}
+	if location, ok := pattern.literalSegment(5); ok && location != "" {
+		_options.Location = core.StringPtr(location)
+	}
+	if len(pattern.segments) == crnSegmentCount && !strings.Contains(pattern.String(), "*") {
+		if crn, err := ParseCRN(pattern.String()); err == nil {
+			_options.ResourceCrn = core.StringPtr(crn.String())
+		}
+	}
+	return _options
+}
@@ -0,0 +1,139 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configurationaggregatorv1_test
+
+import (
+	"github.com/IBM/configuration-aggregator-go-sdk/configurationaggregatorv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ConfigurationAggregatorV1 CRNs`, func() {
+	const bucketCrn = "crn:v1:bluemix:public:cloud-object-storage:global:a/0123456789abcdef:0ba4dba0-a120-4ee5-9f77:bucket:prod-logs"
+	const instanceCrn = "crn:v1:bluemix:public:kms:us-south:a/0123456789abcdef:8d5a1f3e-0c4b-4e2f::"
+
+	It(`Invoke ParseCRN successfully`, func() {
+		crn, err := configurationaggregatorv1.ParseCRN(bucketCrn)
+		Expect(err).To(BeNil())
+		Expect(*crn).To(Equal(configurationaggregatorv1.CRN{
+			Version:         "v1",
+			CName:           "bluemix",
+			CType:           "public",
+			ServiceName:     "cloud-object-storage",
+			Location:        "global",
+			Scope:           "a/0123456789abcdef",
+			ServiceInstance: "0ba4dba0-a120-4ee5-9f77",
+			ResourceType:    "bucket",
+			Resource:        "prod-logs",
+		}))
+		Expect(crn.String()).To(Equal(bucketCrn))
+		Expect(crn.ScopeType()).To(Equal(configurationaggregatorv1.CRN_ScopeType_Account))
+		Expect(crn.ScopeID()).To(Equal("0123456789abcdef"))
+		Expect(crn.AccountID()).To(Equal("0123456789abcdef"))
+		Expect(crn.IsServiceInstance()).To(BeFalse())
+
+		crn, err = configurationaggregatorv1.ParseCRN(instanceCrn)
+		Expect(err).To(BeNil())
+		Expect(crn.IsServiceInstance()).To(BeTrue())
+		Expect(crn.String()).To(Equal(instanceCrn))
+
+		crn, err = configurationaggregatorv1.ParseCRN("crn:v1:bluemix:public:containers-kubernetes:us-south:o/org1::cluster:a:b")
+		Expect(err).To(BeNil())
+		Expect(crn.Resource).To(Equal("a:b"))
+		Expect(crn.AccountID()).To(BeEmpty())
+	})
+	It(`Invoke ParseCRN with error`, func() {
+		for _, invalid := range []string{
+			"",
+			"crn:v1:bluemix:public:kms",
+			"urn:v1:bluemix:public:kms:us-south:a/acct:instance::",
+			"crn::bluemix:public:kms:us-south:a/acct:instance::",
+			"crn:v1:bluemix:public::us-south:a/acct:instance::",
+			"crn:v1:bluemix:public:kms:us-south:acct:instance::",
+			"crn:v1:bluemix:public:kms:us-south:x/acct:instance::",
+			"crn:v1:bluemix:public:kms:us-south:a/:instance::",
+		} {
+			_, err := configurationaggregatorv1.ParseCRN(invalid)
+			Expect(err).ToNot(BeNil(), invalid)
+			Expect(err.Error()).To(ContainSubstring("invalid CRN"), invalid)
+		}
+	})
+	It(`Invoke About.CRN successfully`, func() {
+		config := mockConfig(bucketCrn, "cloud-object-storage", "global", nil)
+		crn, err := config.About.CRN()
+		Expect(err).To(BeNil())
+		Expect(crn.ResourceType).To(Equal("bucket"))
+
+		_, err = new(configurationaggregatorv1.About).CRN()
+		Expect(err).ToNot(BeNil())
+		config = mockConfig("not-a-crn", "kms", "us-south", nil)
+		_, err = config.About.CRN()
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Match CRN patterns`, func() {
+		pattern, err := configurationaggregatorv1.ParseCRNPattern("crn:v1:bluemix:public:cloud-object-storage")
+		Expect(err).To(BeNil())
+		Expect(pattern.Matches(bucketCrn)).To(BeTrue())
+		Expect(pattern.Matches(instanceCrn)).To(BeFalse())
+		Expect(pattern.Matches("not-a-crn")).To(BeFalse())
+
+		pattern, err = configurationaggregatorv1.ParseCRNPattern("crn:v1:*:*:*:*:a/0123456789abcdef:*:bucket:prod-*")
+		Expect(err).To(BeNil())
+		Expect(pattern.Matches(bucketCrn)).To(BeTrue())
+		Expect(pattern.Matches("crn:v1:bluemix:public:cloud-object-storage:global:a/0123456789abcdef:0ba4dba0:bucket:dev-logs")).To(BeFalse())
+		Expect(pattern.String()).To(Equal("crn:v1:*:*:*:*:a/0123456789abcdef:*:bucket:prod-*"))
+
+		configs := []configurationaggregatorv1.Config{
+			mockConfig(bucketCrn, "cloud-object-storage", "global", nil),
+			mockConfig(instanceCrn, "kms", "us-south", nil),
+			{},
+		}
+		Expect(pattern.FilterConfigs(configs)).To(HaveLen(1))
+		Expect(pattern.MatchesConfig(&configs[2])).To(BeFalse())
+
+		for _, invalid := range []string{"", "urn:v1", "crn:v1:*bluemix", "crn:v1:blue*mix"} {
+			_, err = configurationaggregatorv1.ParseCRNPattern(invalid)
+			Expect(err).ToNot(BeNil(), invalid)
+		}
+	})
+	It(`Set ListConfigsOptions filters from CRNs`, func() {
+		crn, err := configurationaggregatorv1.ParseCRN(bucketCrn)
+		Expect(err).To(BeNil())
+		options := new(configurationaggregatorv1.ListConfigsOptions).SetResourceCRN(crn)
+		Expect(options.ResourceCrn).To(Equal(core.StringPtr(bucketCrn)))
+
+		pattern, err := configurationaggregatorv1.ParseCRNPattern("crn:v1:bluemix:public:kms:us-south:a/0123456789abcdef:*")
+		Expect(err).To(BeNil())
+		options = new(configurationaggregatorv1.ListConfigsOptions).SetCRNPattern(pattern)
+		Expect(options.ServiceName).To(Equal(core.StringPtr("kms")))
+		Expect(options.Location).To(Equal(core.StringPtr("us-south")))
+		Expect(options.ResourceCrn).To(BeNil())
+
+		pattern, err = configurationaggregatorv1.ParseCRNPattern("crn:v1:bluemix:public:iam-identity::a/0123456789abcdef::profile:Profile-1")
+		Expect(err).To(BeNil())
+		options = new(configurationaggregatorv1.ListConfigsOptions).SetCRNPattern(pattern)
+		Expect(options.ServiceName).To(Equal(core.StringPtr("iam-identity")))
+		Expect(options.Location).To(BeNil())
+		Expect(options.ResourceCrn).To(Equal(core.StringPtr("crn:v1:bluemix:public:iam-identity::a/0123456789abcdef::profile:Profile-1")))
+
+		pattern, err = configurationaggregatorv1.ParseCRNPattern("crn:v1:bluemix:public:kms*")
+		Expect(err).To(BeNil())
+		options = new(configurationaggregatorv1.ListConfigsOptions).SetCRNPattern(pattern)
+		Expect(options.ServiceName).To(BeNil())
+	})
+})
@@ -0,0 +1,346 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package fakeserver provides a stateful, in-process fake of the Configuration Aggregator service, to test code
+// built on the configurationaggregatorv1 package without IBM Cloud credentials.
+//
+// The fake implements the operations of the service:
+//
+//	GET  /configs                       the filters and the pagination of ListConfigs
+//	GET  /settings                      the current settings
+//	PUT  /settings                      replaces the settings, after validating them like ReplaceSettingsOptions.Validate()
+//	GET  /resource_collection_status    the status of the resource collection
+//	POST /reconcile                     starts a simulated resource collection
+//
+// After a manual reconciliation, the status of the collection goes through "initiated" and "inprogress" before
+// becoming "complete" again, as the status is requested. Once the collection completes, its last configuration
+// refresh time and the one of every resource are set to the current time.
+//
+// A typical test starts a server from a fixture and uses a client connected to it:
+//
+//	server := fakeserver.NewServer(fakeserver.NewFixture(configs...), nil)
+//	defer server.Close()
+//	service, err := server.NewClient()
+package fakeserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/IBM/configuration-aggregator-go-sdk/configurationaggregatorv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/go-openapi/strfmt"
+)
+
+// defaultReconcileSteps is the number of status requests for which a simulated collection remains in each of the
+// "initiated" and "inprogress" states, when Options.ReconcileSteps is not set.
+const defaultReconcileSteps = 1
+
+// Options : The options of a fake server.
+type Options struct {
+	// The number of status requests for which a simulated collection remains in each of the "initiated" and
+	// "inprogress" states. Defaults to 1.
+	ReconcileSteps int
+
+	// Returns the current time, used for the last update time of the settings and the refresh times of the
+	// collection. Defaults to time.Now.
+	Now func() time.Time
+}
+
+// Request : A request received by a fake server.
+type Request struct {
+	Method string
+	Path   string
+	Query  url.Values
+}
+
+// Server : A fake Configuration Aggregator service, listening on a local address. A Server is safe for concurrent use.
+type Server struct {
+	// The base URL of the server, e.g. "http://127.0.0.1:54321".
+	URL string
+
+	httpServer     *httptest.Server
+	reconcileSteps int
+	now            func() time.Time
+
+	mutex    sync.Mutex
+	settings *configurationaggregatorv1.SettingsResponse
+	status   *configurationaggregatorv1.StatusResponse
+	configs  []configurationaggregatorv1.Config
+	pending  []string
+	requests []Request
+}
+
+// NewServer starts a fake server with the state of the fixture. The options may be nil.
+func NewServer(fixture *Fixture, options *Options) *Server {
+	if fixture == nil {
+		fixture = new(Fixture)
+	}
+	if options == nil {
+		options = new(Options)
+	}
+	server := &Server{
+		reconcileSteps: options.ReconcileSteps,
+		now:            options.Now,
+	}
+	if server.reconcileSteps <= 0 {
+		server.reconcileSteps = defaultReconcileSteps
+	}
+	if server.now == nil {
+		server.now = time.Now
+	}
+
+	server.settings = fixture.Settings
+	if server.settings == nil {
+		server.settings = &configurationaggregatorv1.SettingsResponse{
+			ResourceCollectionEnabled: core.BoolPtr(true),
+			Regions:                   []string{configurationaggregatorv1.CollectionRegion_All},
+			LastUpdated:               server.dateTimeNow(),
+		}
+	}
+	server.status = fixture.Status
+	if server.status == nil {
+		server.status = &configurationaggregatorv1.StatusResponse{
+			Status:                core.StringPtr(configurationaggregatorv1.StatusResponse_Status_Complete),
+			LastConfigRefreshTime: server.dateTimeNow(),
+		}
+	}
+	server.configs = append([]configurationaggregatorv1.Config(nil), fixture.Configs...)
+
+	server.httpServer = httptest.NewServer(server)
+	server.URL = server.httpServer.URL
+	return server
+}
+
+// Close shuts down the server.
+func (server *Server) Close() {
+	server.httpServer.Close()
+}
+
+// NewClient returns a client of the server, without authentication.
+func (server *Server) NewClient() (*configurationaggregatorv1.ConfigurationAggregatorV1, error) {
+	return configurationaggregatorv1.NewConfigurationAggregatorV1(&configurationaggregatorv1.ConfigurationAggregatorV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+}
+
+// Configs returns the resource configurations of the server.
+func (server *Server) Configs() []configurationaggregatorv1.Config {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	return append([]configurationaggregatorv1.Config(nil), server.configs...)
+}
+
+// SetConfigs replaces the resource configurations of the server.
+func (server *Server) SetConfigs(configs ...configurationaggregatorv1.Config) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	server.configs = append([]configurationaggregatorv1.Config(nil), configs...)
+}
+
+// Settings returns the current settings.
+func (server *Server) Settings() configurationaggregatorv1.SettingsResponse {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	return *server.settings
+}
+
+// Status returns the current status of the resource collection, without advancing a simulated collection.
+func (server *Server) Status() configurationaggregatorv1.StatusResponse {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	return *server.status
+}
+
+// Requests returns the requests received by the server, in order.
+func (server *Server) Requests() []Request {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	return append([]Request(nil), server.requests...)
+}
+
+// ServeHTTP handles a request to the service. The paths are relative to the URL of the service instance.
+func (server *Server) ServeHTTP(res http.ResponseWriter, req *http.Request) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	server.requests = append(server.requests, Request{Method: req.Method, Path: req.URL.Path, Query: req.URL.Query()})
+	switch req.URL.Path {
+	case "/configs":
+		if req.Method == http.MethodGet {
+			server.listConfigs(res, req)
+			return
+		}
+	case "/settings":
+		switch req.Method {
+		case http.MethodGet:
+			writeJSON(res, http.StatusOK, server.settings)
+			return
+		case http.MethodPut:
+			server.replaceSettings(res, req)
+			return
+		}
+	case "/resource_collection_status":
+		if req.Method == http.MethodGet {
+			server.getStatus(res)
+			return
+		}
+	case "/reconcile":
+		if req.Method == http.MethodPost {
+			server.reconcile(res)
+			return
+		}
+	default:
+		writeError(res, http.StatusNotFound, "not_found", fmt.Sprintf("the path '%s' does not exist", req.URL.Path))
+		return
+	}
+	writeError(res, http.StatusMethodNotAllowed, "method_not_allowed", fmt.Sprintf("the method %s is not allowed on '%s'", req.Method, req.URL.Path))
+}
+
+func (server *Server) listConfigs(res http.ResponseWriter, req *http.Request) {
+	query := req.URL.Query()
+	param := func(name string) *string {
+		if !query.Has(name) {
+			return nil
+		}
+		return core.StringPtr(query.Get(name))
+	}
+	listConfigsOptions := &configurationaggregatorv1.ListConfigsOptions{
+		ConfigType:      param("config_type"),
+		ServiceName:     param("service_name"),
+		ResourceGroupID: param("resource_group_id"),
+		Location:        param("location"),
+		ResourceCrn:     param("resource_crn"),
+		SubAccount:      param("sub_account"),
+		AccessTags:      param("access_tags"),
+		UserTags:        param("user_tags"),
+		ServiceTags:     param("service_tags"),
+		Start:           param("start"),
+	}
+	if limit := param("limit"); limit != nil {
+		var value int64
+		if _, err := fmt.Sscan(*limit, &value); err != nil {
+			writeError(res, http.StatusBadRequest, "invalid_limit", fmt.Sprintf("invalid limit: '%s'", *limit))
+			return
+		}
+		listConfigsOptions.Limit = core.Int64Ptr(value)
+	}
+
+	result, err := configurationaggregatorv1.ListConfigsPage(server.configs, listConfigsOptions)
+	if err != nil {
+		writeError(res, http.StatusBadRequest, "invalid_parameter", err.Error())
+		return
+	}
+	if result.Next != nil {
+		next := *req.URL
+		nextQuery := next.Query()
+		nextQuery.Set("start", *result.Next.Start)
+		next.RawQuery = nextQuery.Encode()
+		result.Next.Href = core.StringPtr(server.URL + next.String())
+	}
+	writeJSON(res, http.StatusOK, result)
+}
+
+func (server *Server) replaceSettings(res http.ResponseWriter, req *http.Request) {
+	settings := new(configurationaggregatorv1.ReplaceSettingsOptions)
+	decoder := json.NewDecoder(req.Body)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(settings); err != nil {
+		writeError(res, http.StatusBadRequest, "invalid_body", fmt.Sprintf("invalid settings: %s", err.Error()))
+		return
+	}
+	if err := settings.Validate(); err != nil {
+		writeError(res, http.StatusBadRequest, "invalid_settings", err.Error())
+		return
+	}
+	server.settings = &configurationaggregatorv1.SettingsResponse{
+		ResourceCollectionEnabled: settings.ResourceCollectionEnabled,
+		TrustedProfileID:          settings.TrustedProfileID,
+		Regions:                   settings.Regions,
+		AdditionalScope:           settings.AdditionalScope,
+		LastUpdated:               server.dateTimeNow(),
+	}
+	writeJSON(res, http.StatusOK, server.settings)
+}
+
+// getStatus returns the status of the resource collection, advancing a simulated collection.
+func (server *Server) getStatus(res http.ResponseWriter) {
+	status := *server.status
+	switch {
+	case len(server.pending) > 0:
+		status.Status = core.StringPtr(server.pending[0])
+		server.pending = server.pending[1:]
+	case core.StringNilMapper(status.Status) != configurationaggregatorv1.StatusResponse_Status_Complete:
+		status.Status = core.StringPtr(configurationaggregatorv1.StatusResponse_Status_Complete)
+		status.LastConfigRefreshTime = server.dateTimeNow()
+		for i := range server.configs {
+			if server.configs[i].About != nil {
+				about := *server.configs[i].About
+				about.LastConfigRefreshTime = status.LastConfigRefreshTime
+				server.configs[i].About = &about
+			}
+		}
+	}
+	server.status = &status
+	writeJSON(res, http.StatusOK, server.status)
+}
+
+// reconcile starts a simulated resource collection.
+func (server *Server) reconcile(res http.ResponseWriter) {
+	server.pending = nil
+	for _, status := range []string{configurationaggregatorv1.StatusResponse_Status_Initiated, configurationaggregatorv1.StatusResponse_Status_Inprogress} {
+		for i := 0; i < server.reconcileSteps; i++ {
+			server.pending = append(server.pending, status)
+		}
+	}
+	status := *server.status
+	status.Status = core.StringPtr(configurationaggregatorv1.StatusResponse_Status_Initiated)
+	server.status = &status
+	writeJSON(res, http.StatusAccepted, &configurationaggregatorv1.ManualReconcileResponse{
+		Message: core.StringPtr("Manual reconciliation initiated"),
+	})
+}
+
+func (server *Server) dateTimeNow() *strfmt.DateTime {
+	now := strfmt.DateTime(server.now().UTC().Truncate(time.Millisecond))
+	return &now
+}
+
+func writeJSON(res http.ResponseWriter, statusCode int, body interface{}) {
+	res.Header().Set("Content-Type", "application/json")
+	res.WriteHeader(statusCode)
+	_ = json.NewEncoder(res).Encode(body)
+}
+
+// writeError writes an error in the format of the IBM Cloud services.
+func writeError(res http.ResponseWriter, statusCode int, code string, message string) {
+	writeJSON(res, statusCode, map[string]interface{}{
+		"errors":      []map[string]string{{"code": code, "message": message}},
+		"status_code": statusCode,
+		"trace":       "fakeserver",
+	})
+}
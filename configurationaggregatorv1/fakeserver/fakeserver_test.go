@@ -0,0 +1,174 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fakeserver_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/IBM/configuration-aggregator-go-sdk/configurationaggregatorv1"
+	"github.com/IBM/configuration-aggregator-go-sdk/configurationaggregatorv1/fakeserver"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+)
+
+const testFixtureYAML = `
+settings:
+  resource_collection_enabled: true
+  trusted_profile_id: Profile-5f2b4d5c-9a8e-4a5b-9c3b-2f1d6e7a8b9c
+  regions: [us-south]
+  last_updated: "2026-01-01T00:00:00.000Z"
+configs:
+  - about:
+      account_id: AccountID
+      config_type: key
+      resource_crn: crn1
+      resource_group_id: rg1
+      service_name: kms
+      resource_name: key1
+      last_config_refresh_time: "2026-01-01T00:00:00.000Z"
+      location: us-south
+      user_tags: ["env:prod"]
+    config:
+      rotation: {enabled: true}
+  - about:
+      account_id: AccountID
+      config_type: key
+      resource_crn: crn2
+      resource_group_id: rg1
+      service_name: kms
+      resource_name: key2
+      last_config_refresh_time: "2026-01-01T00:00:00.000Z"
+      location: eu-de
+    config: {}
+  - about:
+      account_id: AccountID
+      config_type: bucket
+      resource_crn: crn3
+      resource_group_id: rg1
+      service_name: cloud-object-storage
+      resource_name: bucket1
+      last_config_refresh_time: "2026-01-01T00:00:00.000Z"
+      location: global
+      user_tags: ["env:prod"]
+    config: {}
+`
+
+func newTestServer(t *testing.T, options *fakeserver.Options) (*fakeserver.Server, *configurationaggregatorv1.ConfigurationAggregatorV1) {
+	path := filepath.Join(t.TempDir(), "fixture.yaml")
+	assert.Nil(t, os.WriteFile(path, []byte(testFixtureYAML), 0o600))
+	fixture, err := fakeserver.LoadFixture(path)
+	assert.Nil(t, err)
+	assert.Len(t, fixture.Configs, 3)
+
+	server := fakeserver.NewServer(fixture, options)
+	t.Cleanup(server.Close)
+	service, err := server.NewClient()
+	assert.Nil(t, err)
+	return server, service
+}
+
+func TestListConfigs(t *testing.T) {
+	server, service := newTestServer(t, nil)
+
+	pager, err := service.NewConfigsPager(service.NewListConfigsOptions().SetUserTags("env:prod").SetLimit(1))
+	assert.Nil(t, err)
+	configs, err := pager.GetAll()
+	assert.Nil(t, err)
+	assert.Len(t, configs, 2)
+	assert.Equal(t, "crn1", *configs[0].About.ResourceCrn)
+	assert.Equal(t, "crn3", *configs[1].About.ResourceCrn)
+	assert.Equal(t, map[string]interface{}{"enabled": true}, configs[0].Config.GetProperty("rotation"))
+
+	result, _, err := service.ListConfigs(service.NewListConfigsOptions().SetServiceName("kms").SetLocation("eu-de"))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), *result.TotalCount)
+	assert.Nil(t, result.Next)
+
+	_, response, err := service.ListConfigs(service.NewListConfigsOptions().SetStart("not-a-cursor"))
+	assert.NotNil(t, err)
+	assert.Equal(t, 400, response.StatusCode)
+
+	requests := server.Requests()
+	assert.Len(t, requests, 4)
+	assert.Equal(t, "1", requests[1].Query.Get("start"))
+}
+
+func TestSettings(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	server, service := newTestServer(t, &fakeserver.Options{Now: func() time.Time { return now }})
+
+	settings, err := service.EnableRegions(context.Background(), []string{"eu-de"})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"us-south", "eu-de"}, settings.Regions)
+	assert.Equal(t, now, time.Time(*settings.LastUpdated))
+	assert.Equal(t, []string{"us-south", "eu-de"}, server.Settings().Regions)
+	assert.Equal(t, "Profile-5f2b4d5c-9a8e-4a5b-9c3b-2f1d6e7a8b9c", *server.Settings().TrustedProfileID)
+
+	_, response, err := service.ReplaceSettings(service.NewReplaceSettingsOptions().SetRegions([]string{"mars-1"}))
+	assert.NotNil(t, err)
+	assert.Equal(t, 400, response.StatusCode)
+	assert.Contains(t, err.Error(), "'mars-1' is not a supported region")
+}
+
+func TestReconcile(t *testing.T) {
+	server, service := newTestServer(t, &fakeserver.Options{ReconcileSteps: 2})
+
+	var statuses []string
+	result, err := service.ManualReconcileAndWait(context.Background(), service.NewManualReconcileAndWaitOptions().
+		SetWaitOptions(service.NewWaitForCollectionCompleteOptions().
+			SetInterval(time.Millisecond).
+			SetProgressCallback(func(attempt int64, status *configurationaggregatorv1.StatusResponse) {
+				statuses = append(statuses, *status.Status)
+			})).
+		SetListConfigsOptions(service.NewListConfigsOptions().SetServiceName("cloud-object-storage")))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"initiated", "initiated", "inprogress", "inprogress", "complete"}, statuses)
+	assert.Equal(t, "complete", *result.Status.Status)
+	assert.Len(t, result.Configs, 1)
+	assert.Equal(t, time.Time(*result.Status.LastConfigRefreshTime), time.Time(*result.Configs[0].About.LastConfigRefreshTime))
+	assert.True(t, time.Time(*result.Status.LastConfigRefreshTime).After(time.Time(*result.PreviousStatus.LastConfigRefreshTime)))
+	assert.Equal(t, "complete", *server.Status().Status)
+}
+
+func TestDefaultsAndErrors(t *testing.T) {
+	server := fakeserver.NewServer(fakeserver.NewFixture(configurationaggregatorv1.Config{
+		About: &configurationaggregatorv1.About{ResourceCrn: core.StringPtr("crn1")},
+	}), nil)
+	defer server.Close()
+	service, err := server.NewClient()
+	assert.Nil(t, err)
+
+	settings, _, err := service.GetSettings(service.NewGetSettingsOptions())
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"all"}, settings.Regions)
+	status, _, err := service.GetResourceCollectionStatus(service.NewGetResourceCollectionStatusOptions())
+	assert.Nil(t, err)
+	assert.Equal(t, "complete", *status.Status)
+
+	server.SetConfigs()
+	result, _, err := service.ListConfigs(service.NewListConfigsOptions())
+	assert.Nil(t, err)
+	assert.Empty(t, result.Configs)
+
+	_, err = fakeserver.ParseFixture([]byte(`{"config": []}`))
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "unknown fixture property 'config'")
+}
@@ -0,0 +1,120 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fakeserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	common "github.com/IBM/configuration-aggregator-go-sdk/common"
+	"github.com/IBM/configuration-aggregator-go-sdk/configurationaggregatorv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"sigs.k8s.io/yaml"
+)
+
+// Fixture : The initial state of a fake server.
+type Fixture struct {
+	// The settings. Defaults to settings with the resource collection enabled across all regions.
+	Settings *configurationaggregatorv1.SettingsResponse
+
+	// The status of the resource collection. Defaults to a complete collection.
+	Status *configurationaggregatorv1.StatusResponse
+
+	// The resource configurations returned by /configs, in order.
+	Configs []configurationaggregatorv1.Config
+}
+
+// NewFixture returns a fixture holding the specified resource configurations, with the default settings and status.
+func NewFixture(configs ...configurationaggregatorv1.Config) *Fixture {
+	return &Fixture{Configs: configs}
+}
+
+// FixtureFromSnapshot returns a fixture holding the settings, status and resource configurations of a snapshot,
+// e.g. one loaded from a configurationaggregatorv1.SnapshotStore.
+func FixtureFromSnapshot(snapshot *configurationaggregatorv1.Snapshot) *Fixture {
+	return &Fixture{
+		Settings: snapshot.Settings,
+		Status:   snapshot.Status,
+		Configs:  snapshot.Configs,
+	}
+}
+
+// ParseFixture parses a fixture in JSON or YAML. The document has the optional properties "settings", "status" and
+// "configs", in the format of the responses of the corresponding operations, e.g.:
+//
+//	settings:
+//	  resource_collection_enabled: true
+//	  regions: [us-south]
+//	configs:
+//	  - about:
+//	      resource_crn: crn:v1:bluemix:public:kms:us-south:a/0123456789abcdef:0ba4dba0::
+//	      service_name: kms
+//	      ...
+//	    config:
+//	      rotation: {enabled: true}
+func ParseFixture(data []byte) (fixture *Fixture, err error) {
+	data, err = yaml.YAMLToJSON(data)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "invalid-fixture", common.GetComponentInfo())
+		return
+	}
+	var rawFixture map[string]json.RawMessage
+	err = json.Unmarshal(data, &rawFixture)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "invalid-fixture", common.GetComponentInfo())
+		return
+	}
+	for key := range rawFixture {
+		if key != "settings" && key != "status" && key != "configs" {
+			err = core.SDKErrorf(nil, fmt.Sprintf("unknown fixture property '%s'", key), "invalid-fixture", common.GetComponentInfo())
+			return
+		}
+	}
+
+	fixture = new(Fixture)
+	err = core.UnmarshalModel(rawFixture, "settings", &fixture.Settings, configurationaggregatorv1.UnmarshalSettingsResponse)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "settings-error", common.GetComponentInfo())
+		return nil, err
+	}
+	err = core.UnmarshalModel(rawFixture, "status", &fixture.Status, configurationaggregatorv1.UnmarshalStatusResponse)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "status-error", common.GetComponentInfo())
+		return nil, err
+	}
+	err = core.UnmarshalModel(rawFixture, "configs", &fixture.Configs, configurationaggregatorv1.UnmarshalConfig)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "configs-error", common.GetComponentInfo())
+		return nil, err
+	}
+	return
+}
+
+// LoadFixture reads a fixture file in JSON or YAML. See ParseFixture() for the format.
+func LoadFixture(path string) (fixture *Fixture, err error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "fixture-read-error", common.GetComponentInfo())
+		return
+	}
+	fixture, err = ParseFixture(data)
+	if err != nil {
+		err = core.SDKErrorf(err, fmt.Sprintf("invalid fixture file '%s': %s", path, err.Error()), "fixture-load-error", common.GetComponentInfo())
+	}
+	return
+}
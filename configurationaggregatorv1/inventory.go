@@ -0,0 +1,343 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configurationaggregatorv1
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	common "github.com/IBM/configuration-aggregator-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Constants associated with the About fields indexed by an Inventory.
+const (
+	InventoryField_AccessTags      = "access_tags"
+	InventoryField_AccountID       = "account_id"
+	InventoryField_CatalogTags     = "catalog_tags"
+	InventoryField_ConfigType      = "config_type"
+	InventoryField_Location        = "location"
+	InventoryField_ResourceGroupID = "resource_group_id"
+	InventoryField_ServiceName     = "service_name"
+	InventoryField_ServiceTags     = "service_tags"
+	InventoryField_UserTags        = "user_tags"
+)
+
+// inventoryFields returns the values of the indexed fields of a resource. Fields that are not set are indexed
+// with an empty value; tag fields are indexed with each of their tags.
+func inventoryFields(about *About) map[string][]string {
+	if about == nil {
+		about = new(About)
+	}
+	return map[string][]string{
+		InventoryField_AccessTags:      about.AccessTags,
+		InventoryField_AccountID:       {core.StringNilMapper(about.AccountID)},
+		InventoryField_CatalogTags:     about.CatalogTags,
+		InventoryField_ConfigType:      {core.StringNilMapper(about.ConfigType)},
+		InventoryField_Location:        {core.StringNilMapper(about.Location)},
+		InventoryField_ResourceGroupID: {core.StringNilMapper(about.ResourceGroupID)},
+		InventoryField_ServiceName:     {core.StringNilMapper(about.ServiceName)},
+		InventoryField_ServiceTags:     about.ServiceTags,
+		InventoryField_UserTags:        about.UserTags,
+	}
+}
+
+// crnSet is a set of resource CRNs.
+type crnSet map[string]struct{}
+
+// Inventory : An in-memory collection of resource configurations, keyed by CRN and indexed on the fields of their
+// About metadata, e.g. the service name, the location or the tags. An Inventory is safe for concurrent use.
+type Inventory struct {
+	mutex   sync.RWMutex
+	configs map[string]*Config
+
+	// The CRNs of the resources, by field and value.
+	indexes map[string]map[string]crnSet
+}
+
+// NewInventory returns an Inventory holding the specified resource configurations.
+func NewInventory(configs []Config) *Inventory {
+	inventory := &Inventory{
+		configs: make(map[string]*Config),
+		indexes: make(map[string]map[string]crnSet),
+	}
+	for field := range inventoryFields(nil) {
+		inventory.indexes[field] = make(map[string]crnSet)
+	}
+	inventory.Add(configs...)
+	return inventory
+}
+
+// Add adds resource configurations to the inventory. A resource that is already in the inventory is replaced.
+// Resources without a CRN are ignored.
+func (inventory *Inventory) Add(configs ...Config) {
+	inventory.mutex.Lock()
+	defer inventory.mutex.Unlock()
+
+	for i := range configs {
+		if configs[i].About == nil || configs[i].About.ResourceCrn == nil {
+			continue
+		}
+		crn := *configs[i].About.ResourceCrn
+		inventory.remove(crn)
+
+		config := configs[i]
+		inventory.configs[crn] = &config
+		for field, values := range inventoryFields(config.About) {
+			for _, value := range values {
+				set, found := inventory.indexes[field][value]
+				if !found {
+					set = make(crnSet)
+					inventory.indexes[field][value] = set
+				}
+				set[crn] = struct{}{}
+			}
+		}
+	}
+}
+
+// Remove removes the resource with the specified CRN from the inventory, and returns true if it was found.
+func (inventory *Inventory) Remove(crn string) bool {
+	inventory.mutex.Lock()
+	defer inventory.mutex.Unlock()
+
+	return inventory.remove(crn)
+}
+
+func (inventory *Inventory) remove(crn string) bool {
+	config, found := inventory.configs[crn]
+	if !found {
+		return false
+	}
+	delete(inventory.configs, crn)
+	for field, values := range inventoryFields(config.About) {
+		for _, value := range values {
+			set := inventory.indexes[field][value]
+			delete(set, crn)
+			if len(set) == 0 {
+				delete(inventory.indexes[field], value)
+			}
+		}
+	}
+	return true
+}
+
+// Get returns the resource with the specified CRN.
+func (inventory *Inventory) Get(crn string) (config Config, found bool) {
+	inventory.mutex.RLock()
+	defer inventory.mutex.RUnlock()
+
+	if c, ok := inventory.configs[crn]; ok {
+		return *c, true
+	}
+	return
+}
+
+// Len returns the number of resources in the inventory.
+func (inventory *Inventory) Len() int {
+	inventory.mutex.RLock()
+	defer inventory.mutex.RUnlock()
+
+	return len(inventory.configs)
+}
+
+// Values returns the distinct values of an indexed field, sorted. See the InventoryField_* constants.
+func (inventory *Inventory) Values(field string) (values []string, err error) {
+	inventory.mutex.RLock()
+	defer inventory.mutex.RUnlock()
+
+	index, err := inventory.index(field)
+	if err != nil {
+		return
+	}
+	values = make([]string, 0, len(index))
+	for value := range index {
+		values = append(values, value)
+	}
+	sort.Strings(values)
+	return
+}
+
+func (inventory *Inventory) index(field string) (map[string]crnSet, error) {
+	index, found := inventory.indexes[field]
+	if !found {
+		return nil, core.SDKErrorf(nil, fmt.Sprintf("the field '%s' is not indexed", field), "invalid-inventory-field", common.GetComponentInfo())
+	}
+	return index, nil
+}
+
+// InventoryCondition : A condition on an indexed field of the resources.
+type InventoryCondition struct {
+	// The indexed field. See the InventoryField_* constants.
+	Field string
+
+	// The accepted values. A resource satisfies the condition if the field has one of the values,
+	// or for tag fields, if one of its tags is one of the values.
+	Values []string
+}
+
+// InventoryQuery : A query over the resources of an Inventory. A resource matches the query if it satisfies all
+// of its conditions; a query without conditions matches every resource.
+type InventoryQuery struct {
+	Conditions []InventoryCondition
+}
+
+// NewInventoryQuery : Instantiate InventoryQuery
+func NewInventoryQuery() *InventoryQuery {
+	return &InventoryQuery{}
+}
+
+// Where adds a condition to the query: the field must have one of the specified values.
+// Calling Where more than once on the same field requires all the conditions to be satisfied,
+// e.g. to select the resources that have two tags.
+func (query *InventoryQuery) Where(field string, values ...string) *InventoryQuery {
+	query.Conditions = append(query.Conditions, InventoryCondition{Field: field, Values: values})
+	return query
+}
+
+// match returns the CRNs of the resources that match the query.
+func (inventory *Inventory) match(query *InventoryQuery) (result crnSet, err error) {
+	if query == nil || len(query.Conditions) == 0 {
+		result = make(crnSet, len(inventory.configs))
+		for crn := range inventory.configs {
+			result[crn] = struct{}{}
+		}
+		return
+	}
+
+	// Resolve each condition to the union of the sets of its values, then intersect them,
+	// starting with the smallest.
+	candidates := make([]crnSet, len(query.Conditions))
+	for i, condition := range query.Conditions {
+		var index map[string]crnSet
+		index, err = inventory.index(condition.Field)
+		if err != nil {
+			return
+		}
+		if len(condition.Values) == 1 {
+			candidates[i] = index[condition.Values[0]]
+			continue
+		}
+		union := make(crnSet)
+		for _, value := range condition.Values {
+			for crn := range index[value] {
+				union[crn] = struct{}{}
+			}
+		}
+		candidates[i] = union
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return len(candidates[i]) < len(candidates[j])
+	})
+
+	result = make(crnSet, len(candidates[0]))
+	for crn := range candidates[0] {
+		matches := true
+		for _, candidate := range candidates[1:] {
+			if _, found := candidate[crn]; !found {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			result[crn] = struct{}{}
+		}
+	}
+	return
+}
+
+// Query returns the resources that match the query, sorted by CRN.
+func (inventory *Inventory) Query(query *InventoryQuery) (result []Config, err error) {
+	inventory.mutex.RLock()
+	defer inventory.mutex.RUnlock()
+
+	crns, err := inventory.match(query)
+	if err != nil {
+		return
+	}
+	sorted := make([]string, 0, len(crns))
+	for crn := range crns {
+		sorted = append(sorted, crn)
+	}
+	sort.Strings(sorted)
+
+	result = make([]Config, len(sorted))
+	for i, crn := range sorted {
+		result[i] = *inventory.configs[crn]
+	}
+	return
+}
+
+// Count returns the number of resources that match the query.
+func (inventory *Inventory) Count(query *InventoryQuery) (count int64, err error) {
+	inventory.mutex.RLock()
+	defer inventory.mutex.RUnlock()
+
+	crns, err := inventory.match(query)
+	return int64(len(crns)), err
+}
+
+// InventoryGroup : The number of resources that have a value of a field.
+type InventoryGroup struct {
+	// The value of the field. Empty for the resources that do not have the field set.
+	Value string `json:"value"`
+
+	// The number of resources.
+	Count int64 `json:"count"`
+}
+
+// GroupBy groups the resources that match the query by the value of a field, and returns the number of resources
+// in each group, sorted by decreasing count then by value. For tag fields, a resource is counted in the group of each
+// of its tags, and resources without tags are not counted.
+func (inventory *Inventory) GroupBy(field string, query *InventoryQuery) (groups []InventoryGroup, err error) {
+	inventory.mutex.RLock()
+	defer inventory.mutex.RUnlock()
+
+	index, err := inventory.index(field)
+	if err != nil {
+		return
+	}
+	crns, err := inventory.match(query)
+	if err != nil {
+		return
+	}
+
+	groups = []InventoryGroup{}
+	for value, set := range index {
+		var count int64
+		if len(crns) == len(inventory.configs) {
+			count = int64(len(set))
+		} else {
+			for crn := range set {
+				if _, found := crns[crn]; found {
+					count++
+				}
+			}
+		}
+		if count > 0 {
+			groups = append(groups, InventoryGroup{Value: value, Count: count})
+		}
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		if groups[i].Count != groups[j].Count {
+			return groups[i].Count > groups[j].Count
+		}
+		return groups[i].Value < groups[j].Value
+	})
+	return
+}
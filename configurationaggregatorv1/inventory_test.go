@@ -0,0 +1,134 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configurationaggregatorv1_test
+
+import (
+	"github.com/IBM/configuration-aggregator-go-sdk/configurationaggregatorv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ConfigurationAggregatorV1 inventory`, func() {
+	var inventory *configurationaggregatorv1.Inventory
+
+	crnsOf := func(configs []configurationaggregatorv1.Config) (crns []string) {
+		for _, config := range configs {
+			crns = append(crns, *config.About.ResourceCrn)
+		}
+		return
+	}
+
+	BeforeEach(func() {
+		bucket := mockConfig("crn3", "cloud-object-storage", "global", []string{"env:prod", "team:data"})
+		bucket.About.ResourceGroupID = core.StringPtr("rg2")
+		inventory = configurationaggregatorv1.NewInventory([]configurationaggregatorv1.Config{
+			mockConfig("crn1", "kms", "us-south", []string{"env:prod"}),
+			mockConfig("crn2", "kms", "eu-de", []string{"env:dev"}),
+			bucket,
+			mockConfig("crn4", "is", "us-south", nil),
+			{},
+		})
+	})
+
+	It(`Query the inventory successfully`, func() {
+		Expect(inventory.Len()).To(Equal(4))
+
+		configs, err := inventory.Query(configurationaggregatorv1.NewInventoryQuery().
+			Where(configurationaggregatorv1.InventoryField_ServiceName, "kms").
+			Where(configurationaggregatorv1.InventoryField_Location, "us-south", "global"))
+		Expect(err).To(BeNil())
+		Expect(crnsOf(configs)).To(Equal([]string{"crn1"}))
+
+		configs, err = inventory.Query(configurationaggregatorv1.NewInventoryQuery().
+			Where(configurationaggregatorv1.InventoryField_UserTags, "env:prod").
+			Where(configurationaggregatorv1.InventoryField_UserTags, "team:data"))
+		Expect(err).To(BeNil())
+		Expect(crnsOf(configs)).To(Equal([]string{"crn3"}))
+
+		configs, err = inventory.Query(nil)
+		Expect(err).To(BeNil())
+		Expect(crnsOf(configs)).To(Equal([]string{"crn1", "crn2", "crn3", "crn4"}))
+
+		configs, err = inventory.Query(configurationaggregatorv1.NewInventoryQuery().
+			Where(configurationaggregatorv1.InventoryField_ServiceName, "none"))
+		Expect(err).To(BeNil())
+		Expect(configs).To(BeEmpty())
+
+		count, err := inventory.Count(configurationaggregatorv1.NewInventoryQuery().
+			Where(configurationaggregatorv1.InventoryField_ResourceGroupID, "ResourceGroupID"))
+		Expect(err).To(BeNil())
+		Expect(count).To(Equal(int64(3)))
+
+		_, err = inventory.Query(configurationaggregatorv1.NewInventoryQuery().Where("resource_name", "x"))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("not indexed"))
+	})
+	It(`Group the resources of the inventory`, func() {
+		groups, err := inventory.GroupBy(configurationaggregatorv1.InventoryField_ServiceName, nil)
+		Expect(err).To(BeNil())
+		Expect(groups).To(Equal([]configurationaggregatorv1.InventoryGroup{
+			{Value: "kms", Count: 2},
+			{Value: "cloud-object-storage", Count: 1},
+			{Value: "is", Count: 1},
+		}))
+
+		groups, err = inventory.GroupBy(configurationaggregatorv1.InventoryField_UserTags,
+			configurationaggregatorv1.NewInventoryQuery().Where(configurationaggregatorv1.InventoryField_Location, "us-south", "global"))
+		Expect(err).To(BeNil())
+		Expect(groups).To(Equal([]configurationaggregatorv1.InventoryGroup{
+			{Value: "env:prod", Count: 2},
+			{Value: "team:data", Count: 1},
+		}))
+
+		values, err := inventory.Values(configurationaggregatorv1.InventoryField_Location)
+		Expect(err).To(BeNil())
+		Expect(values).To(Equal([]string{"eu-de", "global", "us-south"}))
+
+		_, err = inventory.GroupBy("unknown", nil)
+		Expect(err).ToNot(BeNil())
+		_, err = inventory.Values("unknown")
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Update the inventory`, func() {
+		updated := mockConfig("crn1", "kms", "eu-de", []string{"env:dev"})
+		inventory.Add(updated)
+		Expect(inventory.Len()).To(Equal(4))
+
+		config, found := inventory.Get("crn1")
+		Expect(found).To(BeTrue())
+		Expect(*config.About.Location).To(Equal("eu-de"))
+
+		count, err := inventory.Count(configurationaggregatorv1.NewInventoryQuery().
+			Where(configurationaggregatorv1.InventoryField_UserTags, "env:prod"))
+		Expect(err).To(BeNil())
+		Expect(count).To(Equal(int64(1)))
+
+		Expect(inventory.Remove("crn1")).To(BeTrue())
+		Expect(inventory.Remove("crn1")).To(BeFalse())
+		_, found = inventory.Get("crn1")
+		Expect(found).To(BeFalse())
+
+		values, err := inventory.Values(configurationaggregatorv1.InventoryField_UserTags)
+		Expect(err).To(BeNil())
+		Expect(values).To(Equal([]string{"env:dev", "env:prod", "team:data"}))
+		Expect(inventory.Remove("crn2")).To(BeTrue())
+		values, err = inventory.Values(configurationaggregatorv1.InventoryField_UserTags)
+		Expect(err).To(BeNil())
+		Expect(values).To(Equal([]string{"env:prod", "team:data"}))
+	})
+})
@@ -0,0 +1,278 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package mock provides an in-memory implementation of configurationaggregatorv1.ConfigurationAggregatorAPI that
+// records its calls and returns scripted responses, to test code that depends on the interface without an HTTP
+// server.
+//
+// The response of a call is, in order of precedence:
+//
+//  1. the next response scripted for the operation, e.g. with ScriptListConfigs(), each being returned once;
+//  2. the result of the function set for the operation, e.g. ListConfigsFunc;
+//  3. an error.
+//
+// For example:
+//
+//	client := mock.NewClient()
+//	client.ScriptGetSettings(&configurationaggregatorv1.SettingsResponse{Regions: []string{"all"}}, nil, nil)
+//	err := codeUnderTest(client)
+//	calls := client.CallsOf(mock.Operation_GetSettings)
+package mock
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+
+	common "github.com/IBM/configuration-aggregator-go-sdk/common"
+	"github.com/IBM/configuration-aggregator-go-sdk/configurationaggregatorv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Constants associated with the Call.Operation property.
+const (
+	Operation_GetResourceCollectionStatus = "GetResourceCollectionStatus"
+	Operation_GetSettings                 = "GetSettings"
+	Operation_ListConfigs                 = "ListConfigs"
+	Operation_ManualReconcile             = "ManualReconcile"
+	Operation_ReplaceSettings             = "ReplaceSettings"
+)
+
+// Call : A call to an operation of a Client. The plain and WithContext forms of an operation are recorded alike,
+// with context.Background() as the context of the plain form.
+type Call struct {
+	// The name of the operation, e.g. Operation_ListConfigs.
+	Operation string
+
+	// The context of the call.
+	Context context.Context
+
+	// A copy of the options of the call, e.g. a *configurationaggregatorv1.ListConfigsOptions.
+	Options interface{}
+}
+
+// scriptedResponse is a response scripted for an operation.
+type scriptedResponse[T any] struct {
+	result   T
+	response *core.DetailedResponse
+	err      error
+}
+
+// Client : An in-memory implementation of configurationaggregatorv1.ConfigurationAggregatorAPI.
+// A Client is safe for concurrent use, provided that its functions are not modified while it is in use.
+type Client struct {
+	// The functions called by the operations once their scripted responses are exhausted.
+	ListConfigsFunc                 func(ctx context.Context, listConfigsOptions *configurationaggregatorv1.ListConfigsOptions) (*configurationaggregatorv1.ListConfigsResponse, *core.DetailedResponse, error)
+	ReplaceSettingsFunc             func(ctx context.Context, replaceSettingsOptions *configurationaggregatorv1.ReplaceSettingsOptions) (*configurationaggregatorv1.SettingsResponse, *core.DetailedResponse, error)
+	GetSettingsFunc                 func(ctx context.Context, getSettingsOptions *configurationaggregatorv1.GetSettingsOptions) (*configurationaggregatorv1.SettingsResponse, *core.DetailedResponse, error)
+	GetResourceCollectionStatusFunc func(ctx context.Context, getResourceCollectionStatusOptions *configurationaggregatorv1.GetResourceCollectionStatusOptions) (*configurationaggregatorv1.StatusResponse, *core.DetailedResponse, error)
+	ManualReconcileFunc             func(ctx context.Context, manualReconcileOptions *configurationaggregatorv1.ManualReconcileOptions) (*configurationaggregatorv1.ManualReconcileResponse, *core.DetailedResponse, error)
+
+	mutex                                sync.Mutex
+	calls                                []Call
+	listConfigsResponses                 []scriptedResponse[*configurationaggregatorv1.ListConfigsResponse]
+	replaceSettingsResponses             []scriptedResponse[*configurationaggregatorv1.SettingsResponse]
+	getSettingsResponses                 []scriptedResponse[*configurationaggregatorv1.SettingsResponse]
+	getResourceCollectionStatusResponses []scriptedResponse[*configurationaggregatorv1.StatusResponse]
+	manualReconcileResponses             []scriptedResponse[*configurationaggregatorv1.ManualReconcileResponse]
+}
+
+var _ configurationaggregatorv1.ConfigurationAggregatorAPI = (*Client)(nil)
+
+// NewClient returns a new Client, without scripted responses nor functions.
+func NewClient() *Client {
+	return new(Client)
+}
+
+// Calls returns the calls made to the client, in order.
+func (client *Client) Calls() []Call {
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+
+	return append([]Call(nil), client.calls...)
+}
+
+// CallsOf returns the calls made to the specified operation, in order.
+func (client *Client) CallsOf(operation string) (calls []Call) {
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+
+	for _, call := range client.calls {
+		if call.Operation == operation {
+			calls = append(calls, call)
+		}
+	}
+	return
+}
+
+// Reset forgets the recorded calls and the scripted responses that have not been returned.
+func (client *Client) Reset() {
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+
+	client.calls = nil
+	client.listConfigsResponses = nil
+	client.replaceSettingsResponses = nil
+	client.getSettingsResponses = nil
+	client.getResourceCollectionStatusResponses = nil
+	client.manualReconcileResponses = nil
+}
+
+// invoke records a call, and returns the next scripted response of the operation or else the result of its function.
+// When no response is specified along with a result, a response with the specified status code is returned.
+// A copy of the options is recorded, as a pager updates its options from one page to the next.
+func invoke[O any, T any](client *Client, operation string, ctx context.Context, options *O, scripted *[]scriptedResponse[T],
+	fn func(context.Context, *O) (T, *core.DetailedResponse, error), statusCode int) (result T, response *core.DetailedResponse, err error) {
+	var optionsCopy *O
+	if options != nil {
+		optionsCopy = new(O)
+		*optionsCopy = *options
+	}
+
+	client.mutex.Lock()
+	client.calls = append(client.calls, Call{Operation: operation, Context: ctx, Options: optionsCopy})
+	var next *scriptedResponse[T]
+	if len(*scripted) > 0 {
+		next = &(*scripted)[0]
+		*scripted = (*scripted)[1:]
+	}
+	client.mutex.Unlock()
+
+	switch {
+	case next != nil:
+		result, response, err = next.result, next.response, next.err
+		if response == nil && err == nil {
+			response = &core.DetailedResponse{
+				StatusCode: statusCode,
+				Headers:    http.Header{"Content-Type": []string{"application/json"}},
+				Result:     result,
+			}
+		}
+	case fn != nil:
+		result, response, err = fn(ctx, options)
+	default:
+		err = core.SDKErrorf(nil, fmt.Sprintf("no response scripted for the %s operation", operation), "no-scripted-response", common.GetComponentInfo())
+	}
+	return
+}
+
+// ScriptListConfigs appends a response to those returned by ListConfigs. When the response and the error are nil,
+// a response with the status code 200 and the result is returned.
+func (client *Client) ScriptListConfigs(result *configurationaggregatorv1.ListConfigsResponse, response *core.DetailedResponse, err error) *Client {
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+
+	client.listConfigsResponses = append(client.listConfigsResponses, scriptedResponse[*configurationaggregatorv1.ListConfigsResponse]{result, response, err})
+	return client
+}
+
+// ListConfigs : List of configurations of the resources.
+func (client *Client) ListConfigs(listConfigsOptions *configurationaggregatorv1.ListConfigsOptions) (result *configurationaggregatorv1.ListConfigsResponse, response *core.DetailedResponse, err error) {
+	return client.ListConfigsWithContext(context.Background(), listConfigsOptions)
+}
+
+// ListConfigsWithContext is an alternate form of the ListConfigs method which supports a Context parameter.
+func (client *Client) ListConfigsWithContext(ctx context.Context, listConfigsOptions *configurationaggregatorv1.ListConfigsOptions) (result *configurationaggregatorv1.ListConfigsResponse, response *core.DetailedResponse, err error) {
+	return invoke(client, Operation_ListConfigs, ctx, listConfigsOptions, &client.listConfigsResponses, client.ListConfigsFunc, http.StatusOK)
+}
+
+// ScriptReplaceSettings appends a response to those returned by ReplaceSettings. When the response and the error
+// are nil, a response with the status code 200 and the result is returned.
+func (client *Client) ScriptReplaceSettings(result *configurationaggregatorv1.SettingsResponse, response *core.DetailedResponse, err error) *Client {
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+
+	client.replaceSettingsResponses = append(client.replaceSettingsResponses, scriptedResponse[*configurationaggregatorv1.SettingsResponse]{result, response, err})
+	return client
+}
+
+// ReplaceSettings : Replace the settings for Configuration Aggregator.
+func (client *Client) ReplaceSettings(replaceSettingsOptions *configurationaggregatorv1.ReplaceSettingsOptions) (result *configurationaggregatorv1.SettingsResponse, response *core.DetailedResponse, err error) {
+	return client.ReplaceSettingsWithContext(context.Background(), replaceSettingsOptions)
+}
+
+// ReplaceSettingsWithContext is an alternate form of the ReplaceSettings method which supports a Context parameter.
+func (client *Client) ReplaceSettingsWithContext(ctx context.Context, replaceSettingsOptions *configurationaggregatorv1.ReplaceSettingsOptions) (result *configurationaggregatorv1.SettingsResponse, response *core.DetailedResponse, err error) {
+	return invoke(client, Operation_ReplaceSettings, ctx, replaceSettingsOptions, &client.replaceSettingsResponses, client.ReplaceSettingsFunc, http.StatusOK)
+}
+
+// ScriptGetSettings appends a response to those returned by GetSettings. When the response and the error are nil,
+// a response with the status code 200 and the result is returned.
+func (client *Client) ScriptGetSettings(result *configurationaggregatorv1.SettingsResponse, response *core.DetailedResponse, err error) *Client {
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+
+	client.getSettingsResponses = append(client.getSettingsResponses, scriptedResponse[*configurationaggregatorv1.SettingsResponse]{result, response, err})
+	return client
+}
+
+// GetSettings : Retrieve the settings for Configuration Aggregator.
+func (client *Client) GetSettings(getSettingsOptions *configurationaggregatorv1.GetSettingsOptions) (result *configurationaggregatorv1.SettingsResponse, response *core.DetailedResponse, err error) {
+	return client.GetSettingsWithContext(context.Background(), getSettingsOptions)
+}
+
+// GetSettingsWithContext is an alternate form of the GetSettings method which supports a Context parameter.
+func (client *Client) GetSettingsWithContext(ctx context.Context, getSettingsOptions *configurationaggregatorv1.GetSettingsOptions) (result *configurationaggregatorv1.SettingsResponse, response *core.DetailedResponse, err error) {
+	return invoke(client, Operation_GetSettings, ctx, getSettingsOptions, &client.getSettingsResponses, client.GetSettingsFunc, http.StatusOK)
+}
+
+// ScriptGetResourceCollectionStatus appends a response to those returned by GetResourceCollectionStatus. When the
+// response and the error are nil, a response with the status code 200 and the result is returned.
+func (client *Client) ScriptGetResourceCollectionStatus(result *configurationaggregatorv1.StatusResponse, response *core.DetailedResponse, err error) *Client {
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+
+	client.getResourceCollectionStatusResponses = append(client.getResourceCollectionStatusResponses, scriptedResponse[*configurationaggregatorv1.StatusResponse]{result, response, err})
+	return client
+}
+
+// GetResourceCollectionStatus : Retrieve the status of the resource collection.
+func (client *Client) GetResourceCollectionStatus(getResourceCollectionStatusOptions *configurationaggregatorv1.GetResourceCollectionStatusOptions) (result *configurationaggregatorv1.StatusResponse, response *core.DetailedResponse, err error) {
+	return client.GetResourceCollectionStatusWithContext(context.Background(), getResourceCollectionStatusOptions)
+}
+
+// GetResourceCollectionStatusWithContext is an alternate form of the GetResourceCollectionStatus method which
+// supports a Context parameter.
+func (client *Client) GetResourceCollectionStatusWithContext(ctx context.Context, getResourceCollectionStatusOptions *configurationaggregatorv1.GetResourceCollectionStatusOptions) (result *configurationaggregatorv1.StatusResponse, response *core.DetailedResponse, err error) {
+	return invoke(client, Operation_GetResourceCollectionStatus, ctx, getResourceCollectionStatusOptions, &client.getResourceCollectionStatusResponses, client.GetResourceCollectionStatusFunc, http.StatusOK)
+}
+
+// ScriptManualReconcile appends a response to those returned by ManualReconcile. When the response and the error
+// are nil, a response with the status code 202 and the result is returned.
+func (client *Client) ScriptManualReconcile(result *configurationaggregatorv1.ManualReconcileResponse, response *core.DetailedResponse, err error) *Client {
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+
+	client.manualReconcileResponses = append(client.manualReconcileResponses, scriptedResponse[*configurationaggregatorv1.ManualReconcileResponse]{result, response, err})
+	return client
+}
+
+// ManualReconcile : Trigger a manual resource collection.
+func (client *Client) ManualReconcile(manualReconcileOptions *configurationaggregatorv1.ManualReconcileOptions) (result *configurationaggregatorv1.ManualReconcileResponse, response *core.DetailedResponse, err error) {
+	return client.ManualReconcileWithContext(context.Background(), manualReconcileOptions)
+}
+
+// ManualReconcileWithContext is an alternate form of the ManualReconcile method which supports a Context parameter.
+func (client *Client) ManualReconcileWithContext(ctx context.Context, manualReconcileOptions *configurationaggregatorv1.ManualReconcileOptions) (result *configurationaggregatorv1.ManualReconcileResponse, response *core.DetailedResponse, err error) {
+	return invoke(client, Operation_ManualReconcile, ctx, manualReconcileOptions, &client.manualReconcileResponses, client.ManualReconcileFunc, http.StatusAccepted)
+}
+
+// NewConfigsPager returns a pager whose pages are retrieved with ListConfigsWithContext, and thus recorded and
+// scripted as ListConfigs calls.
+func (client *Client) NewConfigsPager(options *configurationaggregatorv1.ListConfigsOptions) (pager *configurationaggregatorv1.ConfigsPager, err error) {
+	return configurationaggregatorv1.NewConfigsPagerWithClient(client, options)
+}
@@ -0,0 +1,90 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mock_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/IBM/configuration-aggregator-go-sdk/configurationaggregatorv1"
+	"github.com/IBM/configuration-aggregator-go-sdk/configurationaggregatorv1/mock"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+)
+
+// enabledRegions is an example of code that depends on the interface rather than on the concrete client.
+func enabledRegions(ctx context.Context, api configurationaggregatorv1.ConfigurationAggregatorAPI) ([]string, error) {
+	settings, _, err := api.GetSettingsWithContext(ctx, &configurationaggregatorv1.GetSettingsOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return settings.Regions, nil
+}
+
+func TestScriptedResponses(t *testing.T) {
+	client := mock.NewClient()
+	client.ScriptGetSettings(&configurationaggregatorv1.SettingsResponse{Regions: []string{"us-south"}}, nil, nil).
+		ScriptGetSettings(nil, &core.DetailedResponse{StatusCode: 403}, errors.New("forbidden"))
+
+	regions, err := enabledRegions(context.Background(), client)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"us-south"}, regions)
+	_, response, err := client.GetSettings(&configurationaggregatorv1.GetSettingsOptions{})
+	assert.EqualError(t, err, "forbidden")
+	assert.Equal(t, 403, response.StatusCode)
+	_, _, err = client.GetSettings(&configurationaggregatorv1.GetSettingsOptions{})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "no response scripted for the GetSettings operation")
+
+	result, response, err := client.ScriptManualReconcile(&configurationaggregatorv1.ManualReconcileResponse{Message: core.StringPtr("initiated")}, nil, nil).
+		ManualReconcile(&configurationaggregatorv1.ManualReconcileOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, 202, response.StatusCode)
+	assert.Equal(t, result, response.Result)
+
+	assert.Len(t, client.Calls(), 4)
+	assert.Len(t, client.CallsOf(mock.Operation_GetSettings), 3)
+	client.Reset()
+	assert.Empty(t, client.Calls())
+}
+
+func TestFunctionsAndPager(t *testing.T) {
+	client := mock.NewClient()
+	client.ListConfigsFunc = func(ctx context.Context, options *configurationaggregatorv1.ListConfigsOptions) (*configurationaggregatorv1.ListConfigsResponse, *core.DetailedResponse, error) {
+		result, err := configurationaggregatorv1.ListConfigsPage([]configurationaggregatorv1.Config{
+			{About: &configurationaggregatorv1.About{ResourceCrn: core.StringPtr("crn1"), ServiceName: core.StringPtr("kms")}},
+			{About: &configurationaggregatorv1.About{ResourceCrn: core.StringPtr("crn2"), ServiceName: core.StringPtr("kms")}},
+			{About: &configurationaggregatorv1.About{ResourceCrn: core.StringPtr("crn3"), ServiceName: core.StringPtr("is")}},
+		}, options)
+		return result, &core.DetailedResponse{StatusCode: 200, Result: result}, err
+	}
+
+	pager, err := client.NewConfigsPager(&configurationaggregatorv1.ListConfigsOptions{ServiceName: core.StringPtr("kms"), Limit: core.Int64Ptr(1)})
+	assert.Nil(t, err)
+	configs, err := pager.GetAll()
+	assert.Nil(t, err)
+	assert.Len(t, configs, 2)
+
+	calls := client.CallsOf(mock.Operation_ListConfigs)
+	assert.Len(t, calls, 2)
+	assert.Nil(t, calls[0].Options.(*configurationaggregatorv1.ListConfigsOptions).Start)
+	assert.Equal(t, "1", *calls[1].Options.(*configurationaggregatorv1.ListConfigsOptions).Start)
+
+	_, err = client.NewConfigsPager(&configurationaggregatorv1.ListConfigsOptions{Start: core.StringPtr("1")})
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,211 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package recorder
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	common "github.com/IBM/configuration-aggregator-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"sigs.k8s.io/yaml"
+)
+
+// CassetteFormatVersion is the version of the cassette format written by a Recorder.
+const CassetteFormatVersion = 1
+
+// ServiceURLPlaceholder replaces the URL of the service in the recorded response bodies, e.g. in the "next.href"
+// property of ListConfigs responses. It is replaced with the URL of the replaying client.
+const ServiceURLPlaceholder = "{{service_url}}"
+
+// redacted replaces the values of the scrubbed headers.
+const redacted = "REDACTED"
+
+// Cassette : The HTTP exchanges recorded by a Recorder, in order.
+type Cassette struct {
+	// The version of the cassette format.
+	FormatVersion int `json:"format_version"`
+
+	// The recorded exchanges.
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction : A recorded HTTP exchange.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest : A recorded HTTP request.
+type RecordedRequest struct {
+	// The method of the request.
+	Method string `json:"method"`
+
+	// The path and query of the request, relative to the URL of the service, e.g. "/configs?limit=100".
+	URL string `json:"url"`
+
+	// The headers of the request.
+	Headers http.Header `json:"headers,omitempty"`
+
+	// The body of the request.
+	Body string `json:"body,omitempty"`
+}
+
+// RecordedResponse : A recorded HTTP response.
+type RecordedResponse struct {
+	// The status code of the response.
+	StatusCode int `json:"status_code"`
+
+	// The headers of the response.
+	Headers http.Header `json:"headers,omitempty"`
+
+	// The body of the response.
+	Body string `json:"body,omitempty"`
+}
+
+// matches returns true if the recorded request has the method, path and query parameters of the request,
+// regardless of the order of the query parameters. The relative URL is relative to the URL of the service.
+func (request *RecordedRequest) matches(method string, relativeURL *url.URL) bool {
+	if request.Method != method {
+		return false
+	}
+	recorded, err := url.Parse(request.URL)
+	if err != nil {
+		return false
+	}
+	return recorded.Path == relativeURL.Path && recorded.Query().Encode() == relativeURL.Query().Encode()
+}
+
+// LoadCassette reads a cassette file.
+func LoadCassette(path string) (cassette *Cassette, err error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "cassette-read-error", common.GetComponentInfo())
+		return
+	}
+	cassette = new(Cassette)
+	err = yaml.UnmarshalStrict(data, cassette)
+	if err != nil {
+		err = core.SDKErrorf(err, fmt.Sprintf("invalid cassette file '%s': %s", path, err.Error()), "invalid-cassette", common.GetComponentInfo())
+		return nil, err
+	}
+	if cassette.FormatVersion > CassetteFormatVersion {
+		err = core.SDKErrorf(nil, fmt.Sprintf("unsupported version %d of cassette file '%s'", cassette.FormatVersion, path), "invalid-cassette", common.GetComponentInfo())
+		return nil, err
+	}
+	return
+}
+
+// Save writes the cassette to a YAML file, creating its directory if it does not exist.
+func (cassette *Cassette) Save(path string) (err error) {
+	cassette.FormatVersion = CassetteFormatVersion
+	data, err := yaml.Marshal(cassette)
+	if err == nil {
+		err = os.MkdirAll(filepath.Dir(path), 0o750)
+	}
+	if err == nil {
+		err = os.WriteFile(path, data, 0o600)
+	}
+	if err != nil {
+		err = core.SDKErrorf(err, "", "cassette-write-error", common.GetComponentInfo())
+	}
+	return
+}
+
+var (
+	// accountIDPattern matches the account IDs in JSON documents.
+	accountIDPattern = regexp.MustCompile(`"account_id"\s*:\s*"([^"]+)"`)
+
+	// crnAccountPattern matches the account ID of the CRNs with an account scope.
+	crnAccountPattern = regexp.MustCompile(`crn:v1:(?:[^:"\s]*:){4}a/([^:"\s/]+)`)
+)
+
+// scrub removes the secrets from the interactions: the values of the specified headers are replaced with
+// "REDACTED", and each account ID is replaced with a placeholder of the same form, e.g.
+// "00000000000000000000000000000001". The account IDs are those of the "account_id" properties, of the CRNs and
+// of the "sub_account" query parameters found in the interactions, along with the specified ones.
+func (cassette *Cassette) scrub(headers []string, accountIDs []string) {
+	found := make(map[string]bool)
+	var ordered []string
+	addAccountID := func(accountID string) {
+		if accountID != "" && !found[accountID] {
+			found[accountID] = true
+			ordered = append(ordered, accountID)
+		}
+	}
+	for _, accountID := range accountIDs {
+		addAccountID(accountID)
+	}
+	for _, interaction := range cassette.Interactions {
+		for _, text := range []string{interaction.Request.URL, interaction.Request.Body, interaction.Response.Body} {
+			for _, pattern := range []*regexp.Regexp{accountIDPattern, crnAccountPattern} {
+				for _, match := range pattern.FindAllStringSubmatch(text, -1) {
+					addAccountID(match[1])
+				}
+			}
+		}
+		if relativeURL, err := url.Parse(interaction.Request.URL); err == nil {
+			addAccountID(relativeURL.Query().Get("sub_account"))
+		}
+	}
+
+	// The placeholders are assigned in the order in which the account IDs are found, and the longest IDs are replaced
+	// first, so that an ID that is a prefix of another one does not alter it.
+	placeholders := make(map[string]string, len(ordered))
+	for i, accountID := range ordered {
+		placeholders[accountID] = fmt.Sprintf("%032x", i+1)
+	}
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return len(ordered[i]) > len(ordered[j])
+	})
+	var oldnew []string
+	for _, accountID := range ordered {
+		oldnew = append(oldnew, accountID, placeholders[accountID])
+		if escaped := url.QueryEscape(accountID); escaped != accountID {
+			oldnew = append(oldnew, escaped, placeholders[accountID])
+		}
+	}
+	replacer := strings.NewReplacer(oldnew...)
+
+	for i := range cassette.Interactions {
+		interaction := &cassette.Interactions[i]
+		interaction.Request.URL = replacer.Replace(interaction.Request.URL)
+		interaction.Request.Body = replacer.Replace(interaction.Request.Body)
+		interaction.Response.Body = replacer.Replace(interaction.Response.Body)
+		scrubHeaders(interaction.Request.Headers, headers, replacer)
+		scrubHeaders(interaction.Response.Headers, headers, replacer)
+	}
+}
+
+func scrubHeaders(header http.Header, redactedHeaders []string, replacer *strings.Replacer) {
+	for _, values := range header {
+		for i := range values {
+			values[i] = replacer.Replace(values[i])
+		}
+	}
+	for _, name := range redactedHeaders {
+		if values := header.Values(name); len(values) > 0 {
+			header.Set(name, redacted)
+		}
+	}
+}
@@ -0,0 +1,307 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package recorder provides an HTTP transport that records the exchanges of a configurationaggregatorv1 client
+// with the Configuration Aggregator service to a cassette file, and replays them later, so that code built on the
+// SDK can be tested deterministically without IBM Cloud credentials.
+//
+// The exchanges are recorded with their paths relative to the URL of the service, so that a cassette recorded
+// with an instance can be replayed by a client of any URL. Before the cassette is written, the Authorization
+// headers are redacted and the account IDs are replaced with placeholders.
+//
+// A typical test records the cassette once against a real instance, and replays it afterwards:
+//
+//	r, err := recorder.New("testdata/list-configs.yaml", nil)
+//	r.Install(service)
+//	defer r.Stop()
+//
+// In the replay mode, no request reaches the service, but the client still authenticates its requests: configure
+// it with a core.NoAuthAuthenticator when no credentials are available.
+package recorder
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+
+	common "github.com/IBM/configuration-aggregator-go-sdk/common"
+	"github.com/IBM/configuration-aggregator-go-sdk/configurationaggregatorv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Constants associated with the Options.Mode property.
+const (
+	// Replays the cassette if it exists, and records it otherwise.
+	Mode_Auto = "auto"
+
+	// Records a new cassette, replacing any existing one.
+	Mode_Record = "record"
+
+	// Replays an existing cassette.
+	Mode_Replay = "replay"
+)
+
+// defaultScrubbedHeaders are the headers whose values are always redacted.
+var defaultScrubbedHeaders = []string{"Authorization", "Cookie", "Set-Cookie"}
+
+// Options : The options of a Recorder.
+type Options struct {
+	// The mode of the recorder. Defaults to Mode_Auto.
+	Mode string
+
+	// The transport that sends the requests while recording. Defaults to the transport of the client on which the
+	// recorder is installed, or to http.DefaultTransport.
+	Transport http.RoundTripper
+
+	// Additional headers whose values are redacted, besides Authorization, Cookie and Set-Cookie.
+	ScrubHeaders []string
+
+	// Additional account IDs replaced with placeholders, besides those found in the recorded exchanges.
+	AccountIDs []string
+}
+
+// Recorder : An http.RoundTripper that records or replays the exchanges with the service.
+// A Recorder is safe for concurrent use.
+type Recorder struct {
+	path         string
+	mode         string
+	transport    http.RoundTripper
+	scrubHeaders []string
+	accountIDs   []string
+
+	mutex      sync.Mutex
+	serviceURL *url.URL
+	cassette   *Cassette
+	used       []bool
+}
+
+// New returns a recorder of the cassette file at the specified path. In the replay mode, the cassette is loaded.
+// The options may be nil.
+func New(path string, options *Options) (recorder *Recorder, err error) {
+	if options == nil {
+		options = new(Options)
+	}
+	recorder = &Recorder{
+		path:         path,
+		mode:         options.Mode,
+		transport:    options.Transport,
+		scrubHeaders: append(append([]string(nil), defaultScrubbedHeaders...), options.ScrubHeaders...),
+		accountIDs:   options.AccountIDs,
+		serviceURL:   new(url.URL),
+	}
+	switch recorder.mode {
+	case "", Mode_Auto:
+		recorder.mode = Mode_Replay
+		if _, statErr := os.Stat(path); os.IsNotExist(statErr) {
+			recorder.mode = Mode_Record
+		}
+	case Mode_Record, Mode_Replay:
+	default:
+		err = core.SDKErrorf(nil, fmt.Sprintf("unsupported recorder mode '%s'", options.Mode), "invalid-recorder-mode", common.GetComponentInfo())
+		return nil, err
+	}
+
+	if recorder.mode == Mode_Replay {
+		recorder.cassette, err = LoadCassette(path)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		recorder.cassette = new(Cassette)
+	}
+	recorder.used = make([]bool, len(recorder.cassette.Interactions))
+	return
+}
+
+// Mode returns the mode of the recorder, either Mode_Record or Mode_Replay.
+func (recorder *Recorder) Mode() string {
+	return recorder.mode
+}
+
+// Install makes the client send its requests through the recorder. When automatic retries are enabled, each
+// attempt is recorded. The paths of the requests are recorded relative to the URL of the client, which must
+// therefore be set before. The recorder wraps the transport of a copy of the current HTTP client, so that the
+// clients sharing it, e.g. the original and the clones of a client, are not affected. When automatic retries are
+// enabled, the retrying client is shared with the clones of the client, so they are recorded too.
+func (recorder *Recorder) Install(service *configurationaggregatorv1.ConfigurationAggregatorV1) {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+
+	if serviceURL, err := url.Parse(service.GetServiceURL()); err == nil {
+		recorder.serviceURL = serviceURL
+	}
+	client := service.Service.GetHTTPClient()
+	if client == nil {
+		client = core.DefaultHTTPClient()
+	} else {
+		copied := *client
+		client = &copied
+	}
+	if recorder.transport == nil {
+		recorder.transport = client.Transport
+	}
+	client.Transport = recorder
+	service.Service.SetHTTPClient(client)
+}
+
+// RoundTrip records or replays an exchange.
+func (recorder *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	if recorder.mode == Mode_Replay {
+		return recorder.replay(req)
+	}
+	return recorder.record(req)
+}
+
+// relativeURL returns the path and query of the request, relative to the URL of the service.
+func (recorder *Recorder) relativeURL(req *http.Request) *url.URL {
+	relative := &url.URL{Path: req.URL.Path, RawQuery: req.URL.RawQuery}
+	if base := strings.TrimSuffix(recorder.serviceURL.Path, "/"); base != "" && strings.HasPrefix(req.URL.Path, base+"/") {
+		relative.Path = strings.TrimPrefix(req.URL.Path, base)
+	}
+	return relative
+}
+
+// serviceURLString returns the URL of the service, without trailing slash.
+func (recorder *Recorder) serviceURLString() string {
+	return strings.TrimSuffix(recorder.serviceURL.String(), "/")
+}
+
+func (recorder *Recorder) record(req *http.Request) (*http.Response, error) {
+	var requestBody []byte
+	if req.Body != nil {
+		var err error
+		requestBody, err = io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req = req.Clone(req.Context())
+		req.Body = io.NopCloser(bytes.NewReader(requestBody))
+	}
+
+	recorder.mutex.Lock()
+	transport := recorder.transport
+	recorder.mutex.Unlock()
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	res, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	responseBody, err := io.ReadAll(res.Body)
+	_ = res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(responseBody))
+
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+
+	body := string(responseBody)
+	if serviceURL := recorder.serviceURLString(); serviceURL != "" {
+		body = strings.ReplaceAll(body, serviceURL, ServiceURLPlaceholder)
+	}
+	recorder.cassette.Interactions = append(recorder.cassette.Interactions, Interaction{
+		Request: RecordedRequest{
+			Method:  req.Method,
+			URL:     recorder.relativeURL(req).String(),
+			Headers: req.Header.Clone(),
+			Body:    string(requestBody),
+		},
+		Response: RecordedResponse{
+			StatusCode: res.StatusCode,
+			Headers:    res.Header.Clone(),
+			Body:       body,
+		},
+	})
+	recorder.used = append(recorder.used, true)
+	return res, nil
+}
+
+// replay returns the response of the first unused interaction whose request matches, so that successive identical
+// requests, e.g. when polling the status of the resource collection, get the successive recorded responses.
+func (recorder *Recorder) replay(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		_ = req.Body.Close()
+	}
+
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+
+	relativeURL := recorder.relativeURL(req)
+	for i := range recorder.cassette.Interactions {
+		interaction := &recorder.cassette.Interactions[i]
+		if recorder.used[i] || !interaction.Request.matches(req.Method, relativeURL) {
+			continue
+		}
+		recorder.used[i] = true
+
+		body := strings.ReplaceAll(interaction.Response.Body, ServiceURLPlaceholder, recorder.serviceURLString())
+		header := interaction.Response.Headers.Clone()
+		if header == nil {
+			header = make(http.Header)
+		}
+		header.Del("Content-Length")
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			StatusCode:    interaction.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          io.NopCloser(strings.NewReader(body)),
+			ContentLength: int64(len(body)),
+			Request:       req,
+		}, nil
+	}
+	err := core.SDKErrorf(nil, fmt.Sprintf("no recorded interaction for %s %s in cassette '%s'", req.Method, relativeURL.String(), recorder.path),
+		"no-recorded-interaction", common.GetComponentInfo())
+	return nil, err
+}
+
+// Unused returns the number of interactions of the cassette that have not been replayed.
+func (recorder *Recorder) Unused() int {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+
+	count := 0
+	for _, used := range recorder.used {
+		if !used {
+			count++
+		}
+	}
+	return count
+}
+
+// Stop writes the cassette when recording, after scrubbing it. It does nothing in the replay mode.
+func (recorder *Recorder) Stop() error {
+	if recorder.mode != Mode_Record {
+		return nil
+	}
+
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+
+	recorder.cassette.scrub(recorder.scrubHeaders, recorder.accountIDs)
+	return recorder.cassette.Save(recorder.path)
+}
@@ -0,0 +1,159 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package recorder_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/IBM/configuration-aggregator-go-sdk/configurationaggregatorv1"
+	"github.com/IBM/configuration-aggregator-go-sdk/configurationaggregatorv1/fakeserver"
+	"github.com/IBM/configuration-aggregator-go-sdk/configurationaggregatorv1/recorder"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+)
+
+const testAccountID = "9f1b2c3d4e5f60718293a4b5c6d7e8f9"
+
+func testConfig(name string) configurationaggregatorv1.Config {
+	return configurationaggregatorv1.Config{
+		About: &configurationaggregatorv1.About{
+			AccountID:   core.StringPtr(testAccountID),
+			ResourceCrn: core.StringPtr("crn:v1:bluemix:public:kms:us-south:a/" + testAccountID + ":" + name + "::"),
+			ServiceName: core.StringPtr("kms"),
+		},
+	}
+}
+
+// exercise runs the operations recorded and replayed by the tests.
+func exercise(t *testing.T, service *configurationaggregatorv1.ConfigurationAggregatorV1) []configurationaggregatorv1.Config {
+	pager, err := service.NewConfigsPager(service.NewListConfigsOptions().SetLimit(1))
+	assert.Nil(t, err)
+	configs, err := pager.GetAll()
+	assert.Nil(t, err)
+
+	settings, _, err := service.GetSettings(service.NewGetSettingsOptions())
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"all"}, settings.Regions)
+
+	result, err := service.ManualReconcileAndWait(context.Background(), service.NewManualReconcileAndWaitOptions().
+		SetWaitOptions(service.NewWaitForCollectionCompleteOptions().SetInterval(time.Millisecond)))
+	assert.Nil(t, err)
+	assert.Equal(t, "complete", *result.Status.Status)
+	return configs
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassettes", "exercise.yaml")
+
+	server := fakeserver.NewServer(fakeserver.NewFixture(testConfig("key1"), testConfig("key2")), nil)
+	defer server.Close()
+	service, err := configurationaggregatorv1.NewConfigurationAggregatorV1(&configurationaggregatorv1.ConfigurationAggregatorV1Options{
+		URL:           server.URL,
+		Authenticator: &core.BearerTokenAuthenticator{BearerToken: "secret-token"},
+	})
+	assert.Nil(t, err)
+	r, err := recorder.New(path, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, recorder.Mode_Record, r.Mode())
+	r.Install(service)
+	recorded := exercise(t, service)
+	assert.Len(t, recorded, 2)
+	assert.Nil(t, r.Stop())
+
+	data, err := os.ReadFile(path)
+	assert.Nil(t, err)
+	assert.NotContains(t, string(data), testAccountID)
+	assert.NotContains(t, string(data), "secret-token")
+	assert.NotContains(t, string(data), server.URL)
+	assert.Contains(t, string(data), "REDACTED")
+	assert.Contains(t, string(data), "url: /reconcile")
+	cassette, err := recorder.LoadCassette(path)
+	assert.Nil(t, err)
+	assert.Len(t, cassette.Interactions, 8)
+
+	// The cassette is replayed by a client of another URL, without credentials.
+	service, err = configurationaggregatorv1.NewConfigurationAggregatorV1(&configurationaggregatorv1.ConfigurationAggregatorV1Options{
+		URL:           "https://us-south.apprapp.cloud.ibm.com/apprapp/config_aggregator/v1/instances/instance-id",
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	assert.Nil(t, err)
+	r, err = recorder.New(path, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, recorder.Mode_Replay, r.Mode())
+	r.Install(service)
+	replayed := exercise(t, service)
+	assert.Equal(t, 0, r.Unused())
+	assert.Nil(t, r.Stop())
+	assert.Len(t, replayed, 2)
+	assert.Equal(t, "00000000000000000000000000000001", *replayed[0].About.AccountID)
+	assert.Equal(t, "crn:v1:bluemix:public:kms:us-south:a/00000000000000000000000000000001:key2::", *replayed[1].About.ResourceCrn)
+
+	_, _, err = service.GetSettings(service.NewGetSettingsOptions())
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "no recorded interaction for GET /settings")
+}
+
+func TestInstallOnClone(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "clone.yaml")
+
+	server := fakeserver.NewServer(fakeserver.NewFixture(testConfig("key1")), nil)
+	defer server.Close()
+	service, err := configurationaggregatorv1.NewConfigurationAggregatorV1(&configurationaggregatorv1.ConfigurationAggregatorV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	assert.Nil(t, err)
+	client := service.Service.GetHTTPClient()
+	transport := client.Transport
+
+	clone := service.Clone()
+	r, err := recorder.New(path, nil)
+	assert.Nil(t, err)
+	r.Install(clone)
+	assert.True(t, service.Service.GetHTTPClient() == client)
+	assert.True(t, client.Transport == transport)
+
+	_, _, err = service.GetSettings(service.NewGetSettingsOptions())
+	assert.Nil(t, err)
+	_, _, err = clone.GetResourceCollectionStatus(clone.NewGetResourceCollectionStatusOptions())
+	assert.Nil(t, err)
+	assert.Nil(t, r.Stop())
+
+	cassette, err := recorder.LoadCassette(path)
+	assert.Nil(t, err)
+	assert.Len(t, cassette.Interactions, 1)
+	assert.Equal(t, "/resource_collection_status", cassette.Interactions[0].Request.URL)
+}
+
+func TestOptions(t *testing.T) {
+	_, err := recorder.New("cassette.yaml", &recorder.Options{Mode: "rewind"})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "unsupported recorder mode 'rewind'")
+
+	_, err = recorder.New(filepath.Join(t.TempDir(), "missing.yaml"), &recorder.Options{Mode: recorder.Mode_Replay})
+	assert.NotNil(t, err)
+
+	path := filepath.Join(t.TempDir(), "invalid.yaml")
+	assert.Nil(t, os.WriteFile(path, []byte("interaction: []\n"), 0o600))
+	_, err = recorder.New(path, nil)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "invalid cassette file")
+}
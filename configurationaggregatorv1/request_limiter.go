@@ -0,0 +1,295 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configurationaggregatorv1
+
+import (
+	"context"
+	"io"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+
+	common "github.com/IBM/configuration-aggregator-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// RequestLimiterOptions : The options of a RequestLimiter.
+type RequestLimiterOptions struct {
+	// The maximum sustained number of requests per second. Zero means no rate limit.
+	RateLimit float64
+
+	// The maximum number of requests sent at once when the rate limit allows it, i.e. the size of the token bucket.
+	// Defaults to 1.
+	Burst int
+
+	// The maximum number of requests in flight at any time. Zero means no limit.
+	MaxConcurrentRequests int
+}
+
+// RequestLimiterStats : The statistics of a RequestLimiter.
+type RequestLimiterStats struct {
+	// The number of requests sent.
+	Requests int64
+
+	// The number of requests currently waiting to be sent.
+	Waiting int
+
+	// The number of requests currently in flight.
+	InFlight int
+
+	// The total, longest and latest times requests waited before being sent.
+	TotalWait time.Duration
+	MaxWait   time.Duration
+	LastWait  time.Duration
+
+	// The number of responses whose Retry-After header paused the requests.
+	Throttled int64
+
+	// The time until which the requests are paused after a Retry-After header, or the zero time.
+	PausedUntil time.Time
+}
+
+// RequestLimiter : A client-side limiter of the requests sent to the service. It combines a token-bucket rate
+// limiter with a limit on the number of requests in flight, and pauses all requests for the delay specified by the
+// Retry-After header of "429 Too Many Requests" and "503 Service Unavailable" responses.
+//
+// A RequestLimiter is safe for concurrent use, and may be shared by several clients of the same instance, so that
+// they share its limits.
+type RequestLimiter struct {
+	rate      float64
+	burst     float64
+	semaphore chan struct{}
+
+	mutex       sync.Mutex
+	tokens      float64
+	lastRefill  time.Time
+	pausedUntil time.Time
+	stats       RequestLimiterStats
+}
+
+// NewRequestLimiter returns a new RequestLimiter. A limiter with neither a rate limit nor a maximum number of
+// concurrent requests only honors the Retry-After headers.
+func NewRequestLimiter(options *RequestLimiterOptions) *RequestLimiter {
+	if options == nil {
+		options = new(RequestLimiterOptions)
+	}
+	limiter := &RequestLimiter{
+		rate:  math.Max(options.RateLimit, 0),
+		burst: math.Max(float64(options.Burst), 1),
+	}
+	limiter.tokens = limiter.burst
+	limiter.lastRefill = time.Now()
+	if options.MaxConcurrentRequests > 0 {
+		limiter.semaphore = make(chan struct{}, options.MaxConcurrentRequests)
+	}
+	return limiter
+}
+
+// Wait blocks until a request can be sent, or until the context is done. On success, the returned function must be
+// called once the request completes, to release its slot of concurrent requests.
+func (limiter *RequestLimiter) Wait(ctx context.Context) (release func(), err error) {
+	start := time.Now()
+	limiter.mutex.Lock()
+	limiter.stats.Waiting++
+	limiter.mutex.Unlock()
+	defer func() {
+		limiter.mutex.Lock()
+		defer limiter.mutex.Unlock()
+
+		limiter.stats.Waiting--
+		if err == nil {
+			wait := time.Since(start)
+			limiter.stats.Requests++
+			limiter.stats.InFlight++
+			limiter.stats.TotalWait += wait
+			limiter.stats.LastWait = wait
+			if wait > limiter.stats.MaxWait {
+				limiter.stats.MaxWait = wait
+			}
+		}
+	}()
+
+	if limiter.semaphore != nil {
+		select {
+		case limiter.semaphore <- struct{}{}:
+		case <-ctx.Done():
+			err = core.SDKErrorf(ctx.Err(), "", "limiter-wait-error", common.GetComponentInfo())
+			return
+		}
+	}
+
+	for {
+		delay := limiter.reserve()
+		if delay <= 0 {
+			break
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			if limiter.semaphore != nil {
+				<-limiter.semaphore
+			}
+			err = core.SDKErrorf(ctx.Err(), "", "limiter-wait-error", common.GetComponentInfo())
+			return
+		}
+	}
+
+	var once sync.Once
+	release = func() {
+		once.Do(func() {
+			limiter.mutex.Lock()
+			limiter.stats.InFlight--
+			limiter.mutex.Unlock()
+			if limiter.semaphore != nil {
+				<-limiter.semaphore
+			}
+		})
+	}
+	return
+}
+
+// reserve takes a token and returns zero if a request can be sent now, or returns the delay after which to try again.
+func (limiter *RequestLimiter) reserve() time.Duration {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+
+	now := time.Now()
+	if now.Before(limiter.pausedUntil) {
+		return limiter.pausedUntil.Sub(now)
+	}
+	if limiter.rate == 0 {
+		return 0
+	}
+	limiter.tokens = math.Min(limiter.burst, limiter.tokens+now.Sub(limiter.lastRefill).Seconds()*limiter.rate)
+	limiter.lastRefill = now
+	if limiter.tokens < 1 {
+		return time.Duration((1 - limiter.tokens) / limiter.rate * float64(time.Second))
+	}
+	limiter.tokens--
+	return 0
+}
+
+// Observe pauses the requests for the delay specified by the Retry-After header of a "429 Too Many Requests" or
+// "503 Service Unavailable" response.
+func (limiter *RequestLimiter) Observe(response *http.Response) {
+	if response == nil || (response.StatusCode != http.StatusTooManyRequests && response.StatusCode != http.StatusServiceUnavailable) {
+		return
+	}
+	now := time.Now()
+	delay := parseRetryAfter(response.Header.Get("Retry-After"), now)
+	if delay <= 0 {
+		return
+	}
+
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+
+	limiter.stats.Throttled++
+	if pausedUntil := now.Add(delay); pausedUntil.After(limiter.pausedUntil) {
+		limiter.pausedUntil = pausedUntil
+	}
+}
+
+// Stats returns the current statistics of the limiter.
+func (limiter *RequestLimiter) Stats() RequestLimiterStats {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+
+	stats := limiter.stats
+	if time.Now().Before(limiter.pausedUntil) {
+		stats.PausedUntil = limiter.pausedUntil
+	}
+	return stats
+}
+
+// Transport returns an http.RoundTripper that sends the requests through the specified transport, or through
+// http.DefaultTransport if it is nil, once the limiter allows it. A request remains in flight until its response
+// body is closed.
+func (limiter *RequestLimiter) Transport(transport http.RoundTripper) http.RoundTripper {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	return &limitedTransport{limiter: limiter, transport: transport}
+}
+
+// limitedTransport is the transport returned by RequestLimiter.Transport().
+type limitedTransport struct {
+	limiter   *RequestLimiter
+	transport http.RoundTripper
+}
+
+func (t *limitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	release, err := t.limiter.Wait(req.Context())
+	if err != nil {
+		if req.Body != nil {
+			_ = req.Body.Close()
+		}
+		return nil, err
+	}
+	res, err := t.transport.RoundTrip(req)
+	if err != nil {
+		release()
+		return nil, err
+	}
+	t.limiter.Observe(res)
+	res.Body = &releasingBody{ReadCloser: res.Body, release: release}
+	return res, nil
+}
+
+// releasingBody releases the slot of a request in flight when the response body is closed.
+type releasingBody struct {
+	io.ReadCloser
+	release func()
+}
+
+func (body *releasingBody) Close() error {
+	err := body.ReadCloser.Close()
+	body.release()
+	return err
+}
+
+// SetRequestLimiter makes the requests of the client wait for the specified limiter, or removes the current limiter
+// if it is nil. The limiter wraps the transport of a copy of the current HTTP client, so that the clients sharing
+// it, e.g. the original and the clones of a client, are not affected. As a consequence, the limiter must be set
+// again after a call to Service.SetHTTPClient(), and Service.DisableSSLVerification() must be called while no
+// limiter is set. When automatic retries are enabled, the retrying client is shared with the clones of the client,
+// so a limiter set on a clone also applies to the original.
+func (configurationAggregator *ConfigurationAggregatorV1) SetRequestLimiter(limiter *RequestLimiter) {
+	client := configurationAggregator.Service.GetHTTPClient()
+	if client == nil {
+		client = core.DefaultHTTPClient()
+	} else {
+		copied := *client
+		client = &copied
+	}
+	if current, ok := client.Transport.(*limitedTransport); ok {
+		client.Transport = current.transport
+	}
+	if limiter != nil {
+		client.Transport = limiter.Transport(client.Transport)
+	}
+	configurationAggregator.Service.SetHTTPClient(client)
+	configurationAggregator.requestLimiter = limiter
+}
+
+// GetRequestLimiter returns the limiter of the requests of the client, or nil if there is none.
+func (configurationAggregator *ConfigurationAggregatorV1) GetRequestLimiter() *RequestLimiter {
+	return configurationAggregator.requestLimiter
+}
@@ -0,0 +1,192 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configurationaggregatorv1_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/IBM/configuration-aggregator-go-sdk/configurationaggregatorv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ConfigurationAggregatorV1 request limiter`, func() {
+	const statusBody = `{"last_config_refresh_time":"2026-01-01T12:00:00.000Z","status":"complete"}`
+
+	It(`Limit the number of concurrent requests`, func() {
+		var inFlight, maxInFlight int64
+		testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			n := atomic.AddInt64(&inFlight, 1)
+			defer atomic.AddInt64(&inFlight, -1)
+			for {
+				max := atomic.LoadInt64(&maxInFlight)
+				if n <= max || atomic.CompareAndSwapInt64(&maxInFlight, max, n) {
+					break
+				}
+			}
+			time.Sleep(20 * time.Millisecond)
+			res.Header().Set("Content-type", "application/json")
+			fmt.Fprint(res, statusBody)
+		}))
+		defer testServer.Close()
+		configurationAggregatorService, err := configurationaggregatorv1.NewConfigurationAggregatorV1(&configurationaggregatorv1.ConfigurationAggregatorV1Options{
+			URL:                   testServer.URL,
+			Authenticator:         &core.NoAuthAuthenticator{},
+			MaxConcurrentRequests: 2,
+		})
+		Expect(err).To(BeNil())
+
+		var wg sync.WaitGroup
+		for i := 0; i < 6; i++ {
+			wg.Add(1)
+			go func() {
+				defer GinkgoRecover()
+				defer wg.Done()
+				_, _, err := configurationAggregatorService.GetResourceCollectionStatus(configurationAggregatorService.NewGetResourceCollectionStatusOptions())
+				Expect(err).To(BeNil())
+			}()
+		}
+		wg.Wait()
+		Expect(maxInFlight).To(Equal(int64(2)))
+
+		stats := configurationAggregatorService.GetRequestLimiter().Stats()
+		Expect(stats.Requests).To(Equal(int64(6)))
+		Expect(stats.InFlight).To(Equal(0))
+		Expect(stats.Waiting).To(Equal(0))
+		Expect(stats.MaxWait).To(BeNumerically(">=", 20*time.Millisecond))
+	})
+	It(`Limit the rate of the requests, including the pages of a pager`, func() {
+		testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			res.Header().Set("Content-type", "application/json")
+			if req.URL.Query().Get("start") == "" {
+				fmt.Fprint(res, `{"limit":1,"next":{"start":"1"},"configs":[{"about":{"resource_crn":"crn1"}}]}`)
+				return
+			}
+			fmt.Fprint(res, `{"limit":1,"configs":[{"about":{"resource_crn":"crn2"}}]}`)
+		}))
+		defer testServer.Close()
+		configurationAggregatorService, err := configurationaggregatorv1.NewConfigurationAggregatorV1(&configurationaggregatorv1.ConfigurationAggregatorV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+			RateLimit:     20,
+		})
+		Expect(err).To(BeNil())
+
+		start := time.Now()
+		for i := 0; i < 2; i++ {
+			pager, err := configurationAggregatorService.NewConfigsPager(configurationAggregatorService.NewListConfigsOptions())
+			Expect(err).To(BeNil())
+			configs, err := pager.GetAll()
+			Expect(err).To(BeNil())
+			Expect(configs).To(HaveLen(2))
+		}
+		Expect(time.Since(start)).To(BeNumerically(">=", 140*time.Millisecond))
+		Expect(configurationAggregatorService.GetRequestLimiter().Stats().Requests).To(Equal(int64(4)))
+
+		configurationAggregatorService.SetRequestLimiter(nil)
+		Expect(configurationAggregatorService.GetRequestLimiter()).To(BeNil())
+		start = time.Now()
+		for i := 0; i < 4; i++ {
+			_, _, err = configurationAggregatorService.ListConfigs(configurationAggregatorService.NewListConfigsOptions())
+			Expect(err).To(BeNil())
+		}
+		Expect(time.Since(start)).To(BeNumerically("<", 140*time.Millisecond))
+	})
+	It(`Set the limiter of a clone without affecting the original`, func() {
+		testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			res.Header().Set("Content-type", "application/json")
+			fmt.Fprint(res, statusBody)
+		}))
+		defer testServer.Close()
+		limiter := configurationaggregatorv1.NewRequestLimiter(nil)
+		configurationAggregatorService, err := configurationaggregatorv1.NewConfigurationAggregatorV1(&configurationaggregatorv1.ConfigurationAggregatorV1Options{
+			URL:            testServer.URL,
+			Authenticator:  &core.NoAuthAuthenticator{},
+			RequestLimiter: limiter,
+		})
+		Expect(err).To(BeNil())
+		getStatus := func(service *configurationaggregatorv1.ConfigurationAggregatorV1) {
+			_, _, err := service.GetResourceCollectionStatus(service.NewGetResourceCollectionStatusOptions())
+			Expect(err).To(BeNil())
+		}
+
+		clone := configurationAggregatorService.Clone()
+		cloneLimiter := configurationaggregatorv1.NewRequestLimiter(nil)
+		clone.SetRequestLimiter(cloneLimiter)
+		getStatus(configurationAggregatorService)
+		getStatus(clone)
+		Expect(limiter.Stats().Requests).To(Equal(int64(1)))
+		Expect(cloneLimiter.Stats().Requests).To(Equal(int64(1)))
+
+		clone.SetRequestLimiter(nil)
+		getStatus(configurationAggregatorService)
+		getStatus(clone)
+		Expect(configurationAggregatorService.GetRequestLimiter()).To(BeIdenticalTo(limiter))
+		Expect(clone.GetRequestLimiter()).To(BeNil())
+		Expect(limiter.Stats().Requests).To(Equal(int64(2)))
+		Expect(cloneLimiter.Stats().Requests).To(Equal(int64(1)))
+	})
+	It(`Honor the Retry-After header of rate limited responses`, func() {
+		var requests int64
+		testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			res.Header().Set("Content-type", "application/json")
+			if atomic.AddInt64(&requests, 1) == 1 {
+				res.Header().Set("Retry-After", "1")
+				res.WriteHeader(429)
+				fmt.Fprint(res, `{"errors":[{"code":"too_many_requests","message":"Too many requests"}]}`)
+				return
+			}
+			fmt.Fprint(res, statusBody)
+		}))
+		defer testServer.Close()
+		limiter := configurationaggregatorv1.NewRequestLimiter(nil)
+		configurationAggregatorService, err := configurationaggregatorv1.NewConfigurationAggregatorV1(&configurationaggregatorv1.ConfigurationAggregatorV1Options{
+			URL:            testServer.URL,
+			Authenticator:  &core.NoAuthAuthenticator{},
+			RequestLimiter: limiter,
+		})
+		Expect(err).To(BeNil())
+		Expect(configurationAggregatorService.GetRequestLimiter()).To(BeIdenticalTo(limiter))
+
+		_, _, err = configurationAggregatorService.GetResourceCollectionStatus(configurationAggregatorService.NewGetResourceCollectionStatusOptions())
+		Expect(errors.Is(err, configurationaggregatorv1.ErrRateLimited)).To(BeTrue())
+		stats := limiter.Stats()
+		Expect(stats.Throttled).To(Equal(int64(1)))
+		Expect(stats.PausedUntil).ToNot(BeZero())
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		_, _, err = configurationAggregatorService.GetResourceCollectionStatusWithContext(ctx, configurationAggregatorService.NewGetResourceCollectionStatusOptions())
+		Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+		Expect(requests).To(Equal(int64(1)))
+
+		start := time.Now()
+		status, _, err := configurationAggregatorService.GetResourceCollectionStatus(configurationAggregatorService.NewGetResourceCollectionStatusOptions())
+		Expect(err).To(BeNil())
+		Expect(*status.Status).To(Equal("complete"))
+		Expect(time.Since(start)).To(BeNumerically(">=", 800*time.Millisecond))
+		Expect(limiter.Stats().Requests).To(Equal(int64(2)))
+	})
+})
@@ -0,0 +1,184 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configurationaggregatorv1
+
+import (
+	"errors"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	common "github.com/IBM/configuration-aggregator-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// The kinds of service failures. The errors returned by the operations for an error response of the service match
+// them with errors.Is(), e.g.:
+//
+//	_, _, err := configurationAggregatorService.GetSettings(getSettingsOptions)
+//	if errors.Is(err, configurationaggregatorv1.ErrNotFound) {
+//		...
+//	}
+//
+// Use errors.As() with a *ServiceError for the details of the failure, e.g. the delay after which a rate limited
+// request can be retried.
+var (
+	// The service instance or the requested resource does not exist (404).
+	ErrNotFound = errors.New("not found")
+
+	// The credentials are missing, invalid or expired (401).
+	ErrUnauthorized = errors.New("unauthorized")
+
+	// The credentials do not grant access to the operation (403).
+	ErrForbidden = errors.New("forbidden")
+
+	// Too many requests have been sent (429).
+	ErrRateLimited = errors.New("rate limited")
+
+	// The operation requires the resource collection, which is not enabled in the settings. The error also matches
+	// the kind of its status code, e.g. ErrValidation or ErrForbidden.
+	ErrCollectionDisabled = errors.New("resource collection disabled")
+
+	// The request is not valid (400 or 422). A *ValidationError returned by a client-side Validate() method also
+	// matches ErrValidation.
+	ErrValidation = errors.New("validation failed")
+)
+
+// collectionDisabledPattern matches the codes and messages of the service errors reporting that the resource
+// collection is not enabled.
+var collectionDisabledPattern = regexp.MustCompile(`(?i)collection[ _-]?(is[ _-]?)?(disabled|not[ _-]?enabled)`)
+
+// ServiceError : An error response of the service. It is the cause of the errors returned by the operations for
+// error responses, retrieved with errors.As(). It embeds the core.HTTPProblem of the response, which also remains
+// available with errors.As().
+type ServiceError struct {
+	*core.HTTPProblem
+
+	// The status code of the response.
+	StatusCode int
+
+	// The code of the first error of the response body, if any.
+	Code string
+
+	// The message of the first error of the response body, if any.
+	Message string
+
+	// The delay after which the request can be retried, from the Retry-After header of the response. Zero if the
+	// header is absent.
+	RetryAfter time.Duration
+
+	// The kinds of the failure, e.g. ErrNotFound.
+	kinds []error
+}
+
+// Unwrap returns the HTTP problem and the kinds of the failure, so that errors.Is() and errors.As() match them.
+func (e *ServiceError) Unwrap() []error {
+	return append([]error{e.HTTPProblem}, e.kinds...)
+}
+
+// RetryAfter returns the delay after which a rate limited request can be retried, and true if the error is an
+// ErrRateLimited service error whose response specified that delay.
+func RetryAfter(err error) (time.Duration, bool) {
+	var serviceError *ServiceError
+	if errors.As(err, &serviceError) && errors.Is(serviceError, ErrRateLimited) && serviceError.RetryAfter > 0 {
+		return serviceError.RetryAfter, true
+	}
+	return 0, false
+}
+
+// Is returns true for ErrValidation, so that errors.Is() matches client-side and service-side validation failures
+// alike.
+func (e *ValidationError) Is(target error) bool {
+	return target == ErrValidation
+}
+
+// newServiceError returns a *ServiceError for the error returned by BaseService.Request() when the service responds
+// with an error, or the error itself otherwise, e.g. for network failures. The operations pass its result to
+// core.SDKErrorf(), which makes the *ServiceError the cause of the returned error.
+func newServiceError(err error) error {
+	// The HTTP problem of an error returned by the core is only exposed by an SDK problem that wraps it.
+	var httpProblem *core.HTTPProblem
+	if !errors.As(core.SDKErrorf(err, "", "", common.GetComponentInfo()), &httpProblem) || httpProblem.Response == nil {
+		return err
+	}
+
+	serviceError := &ServiceError{
+		HTTPProblem: httpProblem,
+		StatusCode:  httpProblem.Response.GetStatusCode(),
+	}
+	serviceError.Code, serviceError.Message = serviceErrorDetails(httpProblem.Response.GetResult())
+	switch serviceError.StatusCode {
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		serviceError.kinds = append(serviceError.kinds, ErrValidation)
+	case http.StatusUnauthorized:
+		serviceError.kinds = append(serviceError.kinds, ErrUnauthorized)
+	case http.StatusForbidden:
+		serviceError.kinds = append(serviceError.kinds, ErrForbidden)
+	case http.StatusNotFound:
+		serviceError.kinds = append(serviceError.kinds, ErrNotFound)
+	case http.StatusTooManyRequests:
+		serviceError.kinds = append(serviceError.kinds, ErrRateLimited)
+	}
+	if serviceError.StatusCode >= 400 && serviceError.StatusCode < 500 &&
+		(collectionDisabledPattern.MatchString(serviceError.Code) || collectionDisabledPattern.MatchString(serviceError.Message)) {
+		serviceError.kinds = append(serviceError.kinds, ErrCollectionDisabled)
+	}
+	serviceError.RetryAfter = parseRetryAfter(httpProblem.Response.GetHeaders().Get("Retry-After"), time.Now())
+	return serviceError
+}
+
+// serviceErrorDetails returns the code and the message of the first error of an error response body, in the format
+// of the IBM Cloud services, i.e. {"errors":[{"code":"...","message":"..."}]}, or of the "code" and "message"
+// (or "error") properties of the body otherwise.
+func serviceErrorDetails(result interface{}) (code string, message string) {
+	body, ok := result.(map[string]interface{})
+	if !ok {
+		return
+	}
+	if errs, ok := body["errors"].([]interface{}); ok && len(errs) > 0 {
+		if first, ok := errs[0].(map[string]interface{}); ok {
+			body = first
+		}
+	}
+	code, _ = body["code"].(string)
+	message, _ = body["message"].(string)
+	if message == "" {
+		message, _ = body["error"].(string)
+	}
+	return
+}
+
+// parseRetryAfter returns the delay specified by a Retry-After header, either as a number of seconds or as an HTTP
+// date, or zero if it is absent or invalid.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil && date.After(now) {
+		return date.Sub(now)
+	}
+	return 0
+}
@@ -0,0 +1,148 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configurationaggregatorv1_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/configuration-aggregator-go-sdk/configurationaggregatorv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ConfigurationAggregatorV1 service errors`, func() {
+	// newErrorServer returns a server that responds to every request with the specified status, headers and body.
+	newErrorServer := func(statusCode int, headers map[string]string, body string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			res.Header().Set("Content-type", "application/json")
+			for name, value := range headers {
+				res.Header().Set(name, value)
+			}
+			res.WriteHeader(statusCode)
+			fmt.Fprint(res, body)
+		}))
+	}
+	newService := func(url string) *configurationaggregatorv1.ConfigurationAggregatorV1 {
+		configurationAggregatorService, serviceErr := configurationaggregatorv1.NewConfigurationAggregatorV1(&configurationaggregatorv1.ConfigurationAggregatorV1Options{
+			URL:           url,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		return configurationAggregatorService
+	}
+
+	It(`Return errors matching the kind of the status code`, func() {
+		kinds := map[int]error{
+			400: configurationaggregatorv1.ErrValidation,
+			401: configurationaggregatorv1.ErrUnauthorized,
+			403: configurationaggregatorv1.ErrForbidden,
+			404: configurationaggregatorv1.ErrNotFound,
+			422: configurationaggregatorv1.ErrValidation,
+			429: configurationaggregatorv1.ErrRateLimited,
+		}
+		for statusCode, kind := range kinds {
+			testServer := newErrorServer(statusCode, nil, `{"errors":[{"code":"some_code","message":"some message"}],"trace":"trace"}`)
+			configurationAggregatorService := newService(testServer.URL)
+
+			_, _, err := configurationAggregatorService.GetSettingsWithContext(context.Background(), configurationAggregatorService.NewGetSettingsOptions())
+			Expect(errors.Is(err, kind)).To(BeTrue(), fmt.Sprint(statusCode))
+			Expect(errors.Is(err, configurationaggregatorv1.ErrCollectionDisabled)).To(BeFalse())
+			for _, otherKind := range kinds {
+				if otherKind != kind {
+					Expect(errors.Is(err, otherKind)).To(BeFalse(), fmt.Sprint(statusCode))
+				}
+			}
+			testServer.Close()
+		}
+	})
+	It(`Return the details of the service error`, func() {
+		testServer := newErrorServer(404, nil, `{"errors":[{"code":"instance_not_found","message":"The instance does not exist"}],"trace":"trace"}`)
+		defer testServer.Close()
+		configurationAggregatorService := newService(testServer.URL)
+
+		_, response, err := configurationAggregatorService.ListConfigs(configurationAggregatorService.NewListConfigsOptions())
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(404))
+		Expect(err.Error()).To(ContainSubstring("The instance does not exist"))
+		Expect(errors.Is(err, configurationaggregatorv1.ErrNotFound)).To(BeTrue())
+
+		var serviceError *configurationaggregatorv1.ServiceError
+		Expect(errors.As(err, &serviceError)).To(BeTrue())
+		Expect(serviceError.StatusCode).To(Equal(404))
+		Expect(serviceError.Code).To(Equal("instance_not_found"))
+		Expect(serviceError.Message).To(Equal("The instance does not exist"))
+		Expect(serviceError.RetryAfter).To(BeZero())
+
+		var httpProblem *core.HTTPProblem
+		Expect(errors.As(err, &httpProblem)).To(BeTrue())
+		Expect(httpProblem.OperationID).To(Equal("list_configs"))
+		var sdkProblem *core.SDKProblem
+		Expect(errors.As(err, &sdkProblem)).To(BeTrue())
+	})
+	It(`Return the delay after which a rate limited request can be retried`, func() {
+		testServer := newErrorServer(429, map[string]string{"Retry-After": "7"}, `{"errors":[{"code":"too_many_requests","message":"Too many requests"}]}`)
+		defer testServer.Close()
+		configurationAggregatorService := newService(testServer.URL)
+
+		_, _, err := configurationAggregatorService.GetResourceCollectionStatus(configurationAggregatorService.NewGetResourceCollectionStatusOptions())
+		Expect(errors.Is(err, configurationaggregatorv1.ErrRateLimited)).To(BeTrue())
+		delay, ok := configurationaggregatorv1.RetryAfter(err)
+		Expect(ok).To(BeTrue())
+		Expect(delay).To(Equal(7 * time.Second))
+
+		dateServer := newErrorServer(429, map[string]string{"Retry-After": time.Now().Add(time.Minute).UTC().Format(http.TimeFormat)}, ``)
+		defer dateServer.Close()
+		configurationAggregatorService = newService(dateServer.URL)
+		_, _, err = configurationAggregatorService.GetResourceCollectionStatus(configurationAggregatorService.NewGetResourceCollectionStatusOptions())
+		delay, ok = configurationaggregatorv1.RetryAfter(err)
+		Expect(ok).To(BeTrue())
+		Expect(delay).To(BeNumerically("~", time.Minute, 2*time.Second))
+	})
+	It(`Return errors reporting that the resource collection is disabled`, func() {
+		testServer := newErrorServer(400, nil, `{"errors":[{"code":"bad_request","message":"The resource collection is not enabled for this instance"}]}`)
+		defer testServer.Close()
+		configurationAggregatorService := newService(testServer.URL)
+
+		_, _, err := configurationAggregatorService.ManualReconcile(configurationAggregatorService.NewManualReconcileOptions())
+		Expect(errors.Is(err, configurationaggregatorv1.ErrCollectionDisabled)).To(BeTrue())
+		Expect(errors.Is(err, configurationaggregatorv1.ErrValidation)).To(BeTrue())
+		_, ok := configurationaggregatorv1.RetryAfter(err)
+		Expect(ok).To(BeFalse())
+	})
+	It(`Return errors that are not service errors for other failures`, func() {
+		testServer := newErrorServer(200, nil, `{}`)
+		configurationAggregatorService := newService(testServer.URL)
+		testServer.Close()
+
+		_, _, err := configurationAggregatorService.GetSettings(configurationAggregatorService.NewGetSettingsOptions())
+		Expect(err).ToNot(BeNil())
+		var serviceError *configurationaggregatorv1.ServiceError
+		Expect(errors.As(err, &serviceError)).To(BeFalse())
+		Expect(errors.Is(err, configurationaggregatorv1.ErrNotFound)).To(BeFalse())
+	})
+	It(`Match client-side validation errors with ErrValidation`, func() {
+		err := new(configurationaggregatorv1.ReplaceSettingsOptions).SetRegions([]string{"mars-1"}).Validate()
+		Expect(errors.Is(err, configurationaggregatorv1.ErrValidation)).To(BeTrue())
+		Expect(errors.Is(err, configurationaggregatorv1.ErrNotFound)).To(BeFalse())
+	})
+})
@@ -0,0 +1,226 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configurationaggregatorv1
+
+import (
+	"context"
+	"reflect"
+	"sort"
+
+	common "github.com/IBM/configuration-aggregator-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// ApplySettingsOptions : The ApplySettings options.
+type ApplySettingsOptions struct {
+	// The desired settings. Only the properties that are set are managed: a property that is not set keeps its
+	// current value. To remove all the regions or additional scopes, set the property to an empty list.
+	Desired *ReplaceSettingsOptions `validate:"required"`
+
+	// If true, the planned changes are computed and returned, but the settings are not replaced.
+	DryRun *bool
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewApplySettingsOptions : Instantiate ApplySettingsOptions
+func (*ConfigurationAggregatorV1) NewApplySettingsOptions(desired *ReplaceSettingsOptions) *ApplySettingsOptions {
+	return &ApplySettingsOptions{
+		Desired: desired,
+	}
+}
+
+// SetDesired : Allow user to set Desired
+func (_options *ApplySettingsOptions) SetDesired(desired *ReplaceSettingsOptions) *ApplySettingsOptions {
+	_options.Desired = desired
+	return _options
+}
+
+// SetDryRun : Allow user to set DryRun
+func (_options *ApplySettingsOptions) SetDryRun(dryRun bool) *ApplySettingsOptions {
+	_options.DryRun = core.BoolPtr(dryRun)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *ApplySettingsOptions) SetHeaders(param map[string]string) *ApplySettingsOptions {
+	options.Headers = param
+	return options
+}
+
+// ApplySettingsResult : The result of ApplySettings.
+type ApplySettingsResult struct {
+	// The settings before the change.
+	Previous *SettingsResponse
+
+	// The settings sent, or that would be sent in a dry run, to ReplaceSettings: the current settings with
+	// the desired properties applied.
+	Planned *ReplaceSettingsOptions
+
+	// The changes between the current and the planned settings. The order of the regions and of the
+	// additional scopes is not significant.
+	Changes []ValueChange
+
+	// True if the settings were replaced. False if they already matched the desired settings or in a dry run.
+	Applied bool
+
+	// The settings after the change. This is Previous if the settings were not replaced.
+	Settings *SettingsResponse
+}
+
+// ApplySettings reconciles the settings with the desired settings: it retrieves the current settings, applies the
+// properties of the desired settings that are set, and replaces the settings only if the result differs from the
+// current settings. This allows the settings to be managed idempotently. In a dry run, the planned changes are
+// returned without replacing the settings.
+func (configurationAggregator *ConfigurationAggregatorV1) ApplySettings(ctx context.Context, applySettingsOptions *ApplySettingsOptions) (result *ApplySettingsResult, err error) {
+	err = core.ValidateNotNil(applySettingsOptions, "applySettingsOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(applySettingsOptions, "applySettingsOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	current, _, err := configurationAggregator.GetSettingsWithContext(ctx, &GetSettingsOptions{Headers: applySettingsOptions.Headers})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "get-settings-error")
+		return
+	}
+
+	result = &ApplySettingsResult{
+		Previous: current,
+		Planned:  planSettings(current, applySettingsOptions.Desired),
+		Settings: current,
+	}
+	result.Planned.Headers = applySettingsOptions.Headers
+	result.Changes = diffSettings(current, result.Planned)
+	if len(result.Changes) == 0 || (applySettingsOptions.DryRun != nil && *applySettingsOptions.DryRun) {
+		return
+	}
+
+	result.Settings, _, err = configurationAggregator.ReplaceSettingsWithContext(ctx, result.Planned)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "replace-settings-error")
+		return nil, err
+	}
+	result.Applied = true
+	return
+}
+
+// planSettings returns the current settings with the properties of the desired settings that are set applied.
+func planSettings(current *SettingsResponse, desired *ReplaceSettingsOptions) *ReplaceSettingsOptions {
+	planned := settingsToReplaceOptions(current)
+	if desired.ResourceCollectionEnabled != nil {
+		planned.ResourceCollectionEnabled = core.BoolPtr(*desired.ResourceCollectionEnabled)
+	}
+	if desired.TrustedProfileID != nil {
+		planned.TrustedProfileID = core.StringPtr(*desired.TrustedProfileID)
+	}
+	if desired.Regions != nil {
+		planned.Regions = append([]string{}, desired.Regions...)
+	}
+	if desired.AdditionalScope != nil {
+		planned.AdditionalScope = append([]AdditionalScope{}, desired.AdditionalScope...)
+	}
+	return planned
+}
+
+// settingsToReplaceOptions returns ReplaceSettingsOptions that replace the settings with the same values.
+func settingsToReplaceOptions(settings *SettingsResponse) *ReplaceSettingsOptions {
+	options := new(ReplaceSettingsOptions)
+	if settings == nil {
+		return options
+	}
+	if settings.ResourceCollectionEnabled != nil {
+		options.ResourceCollectionEnabled = core.BoolPtr(*settings.ResourceCollectionEnabled)
+	}
+	if settings.TrustedProfileID != nil {
+		options.TrustedProfileID = core.StringPtr(*settings.TrustedProfileID)
+	}
+	if settings.Regions != nil {
+		options.Regions = append([]string{}, settings.Regions...)
+	}
+	if settings.AdditionalScope != nil {
+		options.AdditionalScope = append([]AdditionalScope{}, settings.AdditionalScope...)
+	}
+	return options
+}
+
+// diffSettings returns the changes between the current settings and the settings to send to ReplaceSettings.
+// Each property that differs is reported as a whole, e.g. the list of regions, since the order of the regions
+// and of the additional scopes is not significant.
+func diffSettings(current *SettingsResponse, planned *ReplaceSettingsOptions) (changes []ValueChange) {
+	oldProperties := settingsProperties(settingsToReplaceOptions(current))
+	newProperties := settingsProperties(planned)
+	for _, key := range []string{"additional_scope", "regions", "resource_collection_enabled", "trusted_profile_id"} {
+		oldValue, inOld := oldProperties[key]
+		newValue, inNew := newProperties[key]
+		switch {
+		case !inOld && inNew:
+			changes = append(changes, ValueChange{Path: key, Change: ValueChange_Change_Added, New: newValue})
+		case inOld && !inNew:
+			changes = append(changes, ValueChange{Path: key, Change: ValueChange_Change_Removed, Old: oldValue})
+		case !reflect.DeepEqual(oldValue, newValue):
+			changes = append(changes, ValueChange{Path: key, Change: ValueChange_Change_Modified, Old: oldValue, New: newValue})
+		}
+	}
+	return
+}
+
+// settingsProperties returns the properties of the settings in a form suitable for comparison,
+// with the regions and the additional scopes sorted.
+func settingsProperties(settings *ReplaceSettingsOptions) map[string]interface{} {
+	properties := make(map[string]interface{})
+	if settings.ResourceCollectionEnabled != nil {
+		properties["resource_collection_enabled"] = *settings.ResourceCollectionEnabled
+	}
+	if settings.TrustedProfileID != nil {
+		properties["trusted_profile_id"] = *settings.TrustedProfileID
+	}
+
+	regions := append([]string{}, settings.Regions...)
+	sort.Strings(regions)
+	properties["regions"] = regions
+
+	scopes := make([]interface{}, 0, len(settings.AdditionalScope))
+	for _, scope := range settings.AdditionalScope {
+		scopes = append(scopes, additionalScopeProperties(&scope))
+	}
+	sort.SliceStable(scopes, func(i, j int) bool {
+		return scopes[i].(map[string]interface{})["enterprise_id"].(string) < scopes[j].(map[string]interface{})["enterprise_id"].(string)
+	})
+	properties["additional_scope"] = scopes
+	return properties
+}
+
+func additionalScopeProperties(scope *AdditionalScope) map[string]interface{} {
+	properties := map[string]interface{}{
+		"type":          core.StringNilMapper(scope.Type),
+		"enterprise_id": core.StringNilMapper(scope.EnterpriseID),
+	}
+	if scope.ProfileTemplate != nil {
+		properties["profile_template"] = map[string]interface{}{
+			"id":                 core.StringNilMapper(scope.ProfileTemplate.ID),
+			"trusted_profile_id": core.StringNilMapper(scope.ProfileTemplate.TrustedProfileID),
+		}
+	}
+	return properties
+}
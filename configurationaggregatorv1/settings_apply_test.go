@@ -0,0 +1,177 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configurationaggregatorv1_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+
+	"github.com/IBM/configuration-aggregator-go-sdk/configurationaggregatorv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// mockSettingsServer serves GET and PUT requests on /settings, recording the body of every PUT request.
+type mockSettingsServer struct {
+	*httptest.Server
+
+	mutex    sync.Mutex
+	settings map[string]interface{}
+	gets     int
+	puts     []map[string]interface{}
+
+	// Invoked before each GET request is served, with the number of the request.
+	beforeGet func(n int, settings map[string]interface{})
+}
+
+func newMockSettingsServer(settings string) *mockSettingsServer {
+	server := new(mockSettingsServer)
+	Expect(json.Unmarshal([]byte(settings), &server.settings)).To(Succeed())
+	server.Server = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		defer GinkgoRecover()
+
+		server.mutex.Lock()
+		defer server.mutex.Unlock()
+
+		Expect(req.URL.EscapedPath()).To(Equal("/settings"))
+		switch req.Method {
+		case "GET":
+			server.gets++
+			if server.beforeGet != nil {
+				server.beforeGet(server.gets, server.settings)
+			}
+		case "PUT":
+			var body map[string]interface{}
+			Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+			server.puts = append(server.puts, body)
+			server.settings = body
+			server.settings["last_updated"] = fmt.Sprintf("2026-02-01T00:00:%02d.000Z", len(server.puts))
+		}
+		res.Header().Set("Content-type", "application/json")
+		res.WriteHeader(200)
+		Expect(json.NewEncoder(res).Encode(server.settings)).To(Succeed())
+	}))
+	return server
+}
+
+func (server *mockSettingsServer) service() *configurationaggregatorv1.ConfigurationAggregatorV1 {
+	configurationAggregatorService, serviceErr := configurationaggregatorv1.NewConfigurationAggregatorV1(&configurationaggregatorv1.ConfigurationAggregatorV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	Expect(serviceErr).To(BeNil())
+	return configurationAggregatorService
+}
+
+const mockSettingsJSON = `{
+	"resource_collection_enabled": true,
+	"trusted_profile_id": "Profile-39acf232-8969-4c32-9838-83eb60a037f7",
+	"last_updated": "2026-01-01T00:00:00.000Z",
+	"regions": ["us-south", "eu-de"],
+	"additional_scope": [{
+		"type": "Enterprise",
+		"enterprise_id": "2c99aed413954f93b7cf7ce9fda6de61",
+		"profile_template": {
+			"id": "ProfileTemplate-adb55769-ae22-4c60-aead-bd1f84f93c57",
+			"trusted_profile_id": "Profile-39acf232-8969-4c32-9838-83eb60a037f7"
+		}
+	}]
+}`
+
+var _ = Describe(`ConfigurationAggregatorV1 settings reconciliation`, func() {
+	var server *mockSettingsServer
+
+	BeforeEach(func() {
+		server = newMockSettingsServer(mockSettingsJSON)
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Invoke ApplySettings successfully`, func() {
+		configurationAggregatorService := server.service()
+
+		desired := configurationAggregatorService.NewReplaceSettingsOptions().
+			SetRegions([]string{"eu-de", "us-south", "jp-tok"})
+		result, err := configurationAggregatorService.ApplySettings(context.Background(),
+			configurationAggregatorService.NewApplySettingsOptions(desired))
+		Expect(err).To(BeNil())
+		Expect(result.Applied).To(BeTrue())
+		Expect(result.Changes).To(Equal([]configurationaggregatorv1.ValueChange{
+			{Path: "regions", Change: "modified", Old: []string{"eu-de", "us-south"}, New: []string{"eu-de", "jp-tok", "us-south"}},
+		}))
+		Expect(*result.Previous.LastUpdated).To(Equal(*CreateMockDateTime("2026-01-01T00:00:00.000Z")))
+		Expect(*result.Settings.LastUpdated).To(Equal(*CreateMockDateTime("2026-02-01T00:00:01.000Z")))
+
+		// The properties that are not set in the desired settings are preserved.
+		Expect(server.puts).To(HaveLen(1))
+		Expect(server.puts[0]["regions"]).To(Equal([]interface{}{"eu-de", "us-south", "jp-tok"}))
+		Expect(server.puts[0]["resource_collection_enabled"]).To(Equal(true))
+		Expect(server.puts[0]["trusted_profile_id"]).To(Equal("Profile-39acf232-8969-4c32-9838-83eb60a037f7"))
+		Expect(server.puts[0]["additional_scope"]).To(HaveLen(1))
+
+		// Applying the same settings again is a no-op.
+		result, err = configurationAggregatorService.ApplySettings(context.Background(),
+			configurationAggregatorService.NewApplySettingsOptions(desired))
+		Expect(err).To(BeNil())
+		Expect(result.Applied).To(BeFalse())
+		Expect(result.Changes).To(BeEmpty())
+		Expect(result.Settings).To(Equal(result.Previous))
+		Expect(server.puts).To(HaveLen(1))
+	})
+	It(`Invoke ApplySettings in a dry run`, func() {
+		configurationAggregatorService := server.service()
+
+		desired := configurationAggregatorService.NewReplaceSettingsOptions().
+			SetResourceCollectionEnabled(false).
+			SetAdditionalScope([]configurationaggregatorv1.AdditionalScope{})
+		options := configurationAggregatorService.NewApplySettingsOptions(desired).SetDryRun(true)
+		result, err := configurationAggregatorService.ApplySettings(context.Background(), options)
+		Expect(err).To(BeNil())
+		Expect(result.Applied).To(BeFalse())
+		Expect(result.Changes).To(HaveLen(2))
+		Expect(result.Changes[0].Path).To(Equal("additional_scope"))
+		Expect(result.Changes[0].Change).To(Equal(configurationaggregatorv1.ValueChange_Change_Modified))
+		Expect(result.Changes[0].Old).To(HaveLen(1))
+		Expect(result.Changes[0].New).To(BeEmpty())
+		Expect(result.Changes[1]).To(Equal(configurationaggregatorv1.ValueChange{
+			Path: "resource_collection_enabled", Change: "modified", Old: true, New: false,
+		}))
+		Expect(*result.Planned.ResourceCollectionEnabled).To(BeFalse())
+		Expect(result.Planned.AdditionalScope).To(BeEmpty())
+		Expect(result.Planned.Regions).To(Equal([]string{"us-south", "eu-de"}))
+		Expect(server.puts).To(BeEmpty())
+	})
+	It(`Invoke ApplySettings with error`, func() {
+		configurationAggregatorService := server.service()
+
+		_, err := configurationAggregatorService.ApplySettings(context.Background(), nil)
+		Expect(err).ToNot(BeNil())
+		_, err = configurationAggregatorService.ApplySettings(context.Background(), configurationAggregatorService.NewApplySettingsOptions(nil))
+		Expect(err).ToNot(BeNil())
+
+		server.Close()
+		_, err = configurationAggregatorService.ApplySettings(context.Background(),
+			configurationAggregatorService.NewApplySettingsOptions(configurationAggregatorService.NewReplaceSettingsOptions()))
+		Expect(err).ToNot(BeNil())
+	})
+})
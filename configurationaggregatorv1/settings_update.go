@@ -0,0 +1,284 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configurationaggregatorv1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	common "github.com/IBM/configuration-aggregator-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/go-openapi/strfmt"
+)
+
+// defaultUpdateSettingsMaxRetries is the number of times an update is retried after a conflict
+// when UpdateSettingsOptions.MaxRetries is not set.
+const defaultUpdateSettingsMaxRetries = 2
+
+// AdditionalScope_Type_Enterprise is the type of the additional scopes of Enterprise accounts.
+const AdditionalScope_Type_Enterprise = "Enterprise"
+
+// SettingsUpdateFunc modifies the settings in place. It is invoked with a copy of the current settings,
+// and may be invoked more than once if a concurrent update is detected.
+type SettingsUpdateFunc func(settings *ReplaceSettingsOptions) error
+
+// UpdateSettingsOptions : The UpdateSettings options.
+type UpdateSettingsOptions struct {
+	// The function that applies the update to the current settings.
+	Update SettingsUpdateFunc `validate:"required"`
+
+	// The number of times the update is retried when the settings are modified concurrently. Defaults to 2.
+	MaxRetries *int64
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewUpdateSettingsOptions : Instantiate UpdateSettingsOptions
+func (*ConfigurationAggregatorV1) NewUpdateSettingsOptions(update SettingsUpdateFunc) *UpdateSettingsOptions {
+	return &UpdateSettingsOptions{
+		Update: update,
+	}
+}
+
+// SetUpdate : Allow user to set Update
+func (_options *UpdateSettingsOptions) SetUpdate(update SettingsUpdateFunc) *UpdateSettingsOptions {
+	_options.Update = update
+	return _options
+}
+
+// SetMaxRetries : Allow user to set MaxRetries
+func (_options *UpdateSettingsOptions) SetMaxRetries(maxRetries int64) *UpdateSettingsOptions {
+	_options.MaxRetries = core.Int64Ptr(maxRetries)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *UpdateSettingsOptions) SetHeaders(param map[string]string) *UpdateSettingsOptions {
+	options.Headers = param
+	return options
+}
+
+// SettingsConflictError is the cause of the error returned by UpdateSettings when the settings kept being modified
+// concurrently until the retries were exhausted. Use errors.As() to retrieve it from the returned error.
+type SettingsConflictError struct {
+	// The last update time of the settings the update was based on.
+	Expected *strfmt.DateTime
+
+	// The last update time of the settings when the update was about to be sent.
+	Actual *strfmt.DateTime
+}
+
+// Error returns the message of the error.
+func (e *SettingsConflictError) Error() string {
+	return fmt.Sprintf("the settings were modified concurrently (last updated at %s, expected %s)",
+		formatLastUpdated(e.Actual), formatLastUpdated(e.Expected))
+}
+
+func formatLastUpdated(lastUpdated *strfmt.DateTime) string {
+	if lastUpdated == nil {
+		return "unknown"
+	}
+	return lastUpdated.String()
+}
+
+// UpdateSettings applies a partial update to the settings with a read-modify-write cycle: it retrieves the current
+// settings, applies the update to a copy of them, and replaces the settings with the result if it differs.
+// Before the settings are replaced, their LastUpdated time is checked again; if it changed, the settings were
+// modified concurrently and the cycle is retried with the new settings. Once the retries are exhausted, the returned
+// error is caused by a *SettingsConflictError. The service does not support conditional updates, so a concurrent
+// modification made between this check and the replacement cannot be detected.
+func (configurationAggregator *ConfigurationAggregatorV1) UpdateSettings(ctx context.Context, updateSettingsOptions *UpdateSettingsOptions) (result *SettingsResponse, err error) {
+	err = core.ValidateNotNil(updateSettingsOptions, "updateSettingsOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(updateSettingsOptions, "updateSettingsOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	maxRetries := int64(defaultUpdateSettingsMaxRetries)
+	if updateSettingsOptions.MaxRetries != nil {
+		maxRetries = *updateSettingsOptions.MaxRetries
+	}
+	getSettingsOptions := &GetSettingsOptions{Headers: updateSettingsOptions.Headers}
+
+	current, _, err := configurationAggregator.GetSettingsWithContext(ctx, getSettingsOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "get-settings-error")
+		return
+	}
+	for attempt := int64(0); ; attempt++ {
+		planned := settingsToReplaceOptions(current)
+		err = updateSettingsOptions.Update(planned)
+		if err != nil {
+			err = core.SDKErrorf(err, "", "settings-update-error", common.GetComponentInfo())
+			return nil, err
+		}
+		if len(diffSettings(current, planned)) == 0 {
+			return current, nil
+		}
+
+		var latest *SettingsResponse
+		latest, _, err = configurationAggregator.GetSettingsWithContext(ctx, getSettingsOptions)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "get-settings-error")
+			return nil, err
+		}
+		if !sameLastUpdated(current.LastUpdated, latest.LastUpdated) {
+			if attempt >= maxRetries {
+				conflictErr := &SettingsConflictError{
+					Expected: current.LastUpdated,
+					Actual:   latest.LastUpdated,
+				}
+				err = core.SDKErrorf(conflictErr, "", "settings-conflict", common.GetComponentInfo())
+				return nil, err
+			}
+			current = latest
+			continue
+		}
+
+		planned.Headers = updateSettingsOptions.Headers
+		result, _, err = configurationAggregator.ReplaceSettingsWithContext(ctx, planned)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "replace-settings-error")
+			return nil, err
+		}
+		return
+	}
+}
+
+func sameLastUpdated(a *strfmt.DateTime, b *strfmt.DateTime) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return time.Time(*a).Equal(time.Time(*b))
+}
+
+// EnableRegions adds the specified regions to the regions across which the resource collection is enabled.
+// Regions that are already enabled are ignored, and so are all regions if the resource collection is enabled for
+// "all" regions. Enabling "all" regions replaces the specific regions. See UpdateSettings() for details.
+func (configurationAggregator *ConfigurationAggregatorV1) EnableRegions(ctx context.Context, regions []string) (result *SettingsResponse, err error) {
+	result, err = configurationAggregator.UpdateSettings(ctx, configurationAggregator.NewUpdateSettingsOptions(func(settings *ReplaceSettingsOptions) error {
+		if containsString(settings.Regions, CollectionRegion_All) {
+			return nil
+		}
+		if containsString(regions, CollectionRegion_All) {
+			settings.Regions = []string{CollectionRegion_All}
+			return nil
+		}
+		for _, region := range regions {
+			if !containsString(settings.Regions, region) {
+				settings.Regions = append(settings.Regions, region)
+			}
+		}
+		return nil
+	}))
+	err = core.RepurposeSDKProblem(err, "enable-regions-error")
+	return
+}
+
+// DisableRegions removes the specified regions from the regions across which the resource collection is enabled.
+// Regions that are not enabled are ignored. An error is returned if the resource collection is enabled for "all"
+// regions and a specific region is disabled, as the remaining regions are unknown. See UpdateSettings() for details.
+func (configurationAggregator *ConfigurationAggregatorV1) DisableRegions(ctx context.Context, regions []string) (result *SettingsResponse, err error) {
+	result, err = configurationAggregator.UpdateSettings(ctx, configurationAggregator.NewUpdateSettingsOptions(func(settings *ReplaceSettingsOptions) error {
+		remaining := []string{}
+		for _, region := range settings.Regions {
+			if !containsString(regions, region) {
+				remaining = append(remaining, region)
+			}
+		}
+		if containsString(remaining, CollectionRegion_All) && len(regions) > 0 {
+			return fmt.Errorf("the resource collection is enabled for all regions; specify the enabled regions explicitly to disable %v", regions)
+		}
+		settings.Regions = remaining
+		return nil
+	}))
+	err = core.RepurposeSDKProblem(err, "disable-regions-error")
+	return
+}
+
+// AddEnterpriseScope adds an additional scope that enables the resource collection for an Enterprise account.
+// An existing scope with the same enterprise ID is replaced. If the type of the scope is not set, it defaults to
+// "Enterprise". See UpdateSettings() for details.
+func (configurationAggregator *ConfigurationAggregatorV1) AddEnterpriseScope(ctx context.Context, scope *AdditionalScope) (result *SettingsResponse, err error) {
+	err = core.ValidateNotNil(scope, "scope cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	if scope.EnterpriseID == nil {
+		err = core.SDKErrorf(nil, "the enterprise ID of the scope must be specified", "missing-enterprise-id", common.GetComponentInfo())
+		return
+	}
+	added := *scope
+	if added.Type == nil {
+		added.Type = core.StringPtr(AdditionalScope_Type_Enterprise)
+	}
+
+	result, err = configurationAggregator.UpdateSettings(ctx, configurationAggregator.NewUpdateSettingsOptions(func(settings *ReplaceSettingsOptions) error {
+		settings.AdditionalScope = append(removeEnterpriseScope(settings.AdditionalScope, *added.EnterpriseID), added)
+		return nil
+	}))
+	err = core.RepurposeSDKProblem(err, "add-enterprise-scope-error")
+	return
+}
+
+// RemoveEnterpriseScope removes the additional scope of the specified Enterprise account, if it exists.
+// See UpdateSettings() for details.
+func (configurationAggregator *ConfigurationAggregatorV1) RemoveEnterpriseScope(ctx context.Context, enterpriseID string) (result *SettingsResponse, err error) {
+	result, err = configurationAggregator.UpdateSettings(ctx, configurationAggregator.NewUpdateSettingsOptions(func(settings *ReplaceSettingsOptions) error {
+		settings.AdditionalScope = removeEnterpriseScope(settings.AdditionalScope, enterpriseID)
+		return nil
+	}))
+	err = core.RepurposeSDKProblem(err, "remove-enterprise-scope-error")
+	return
+}
+
+// SetTrustedProfile sets the trusted profile that provides Reader access to the App Configuration instance to
+// collect resource metadata. See UpdateSettings() for details.
+func (configurationAggregator *ConfigurationAggregatorV1) SetTrustedProfile(ctx context.Context, trustedProfileID string) (result *SettingsResponse, err error) {
+	result, err = configurationAggregator.UpdateSettings(ctx, configurationAggregator.NewUpdateSettingsOptions(func(settings *ReplaceSettingsOptions) error {
+		settings.TrustedProfileID = core.StringPtr(trustedProfileID)
+		return nil
+	}))
+	err = core.RepurposeSDKProblem(err, "set-trusted-profile-error")
+	return
+}
+
+func removeEnterpriseScope(scopes []AdditionalScope, enterpriseID string) []AdditionalScope {
+	remaining := []AdditionalScope{}
+	for _, scope := range scopes {
+		if core.StringNilMapper(scope.EnterpriseID) != enterpriseID {
+			remaining = append(remaining, scope)
+		}
+	}
+	return remaining
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
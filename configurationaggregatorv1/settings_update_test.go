@@ -0,0 +1,185 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configurationaggregatorv1_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/IBM/configuration-aggregator-go-sdk/configurationaggregatorv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ConfigurationAggregatorV1 partial settings updates`, func() {
+	var server *mockSettingsServer
+
+	BeforeEach(func() {
+		server = newMockSettingsServer(mockSettingsJSON)
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It(`Invoke EnableRegions and DisableRegions successfully`, func() {
+		configurationAggregatorService := server.service()
+
+		result, err := configurationAggregatorService.EnableRegions(context.Background(), []string{"jp-tok", "us-south"})
+		Expect(err).To(BeNil())
+		Expect(result.Regions).To(Equal([]string{"us-south", "eu-de", "jp-tok"}))
+		Expect(server.puts).To(HaveLen(1))
+		Expect(server.puts[0]["trusted_profile_id"]).To(Equal("Profile-39acf232-8969-4c32-9838-83eb60a037f7"))
+		Expect(server.puts[0]["additional_scope"]).To(HaveLen(1))
+
+		result, err = configurationAggregatorService.DisableRegions(context.Background(), []string{"us-south", "br-sao"})
+		Expect(err).To(BeNil())
+		Expect(result.Regions).To(Equal([]string{"eu-de", "jp-tok"}))
+		Expect(server.puts).To(HaveLen(2))
+
+		// No request is sent when the settings are unchanged.
+		result, err = configurationAggregatorService.EnableRegions(context.Background(), []string{"eu-de"})
+		Expect(err).To(BeNil())
+		Expect(result.Regions).To(Equal([]string{"eu-de", "jp-tok"}))
+		Expect(server.puts).To(HaveLen(2))
+	})
+	It(`Invoke EnableRegions with all regions`, func() {
+		server.settings["regions"] = []interface{}{"all"}
+		configurationAggregatorService := server.service()
+
+		result, err := configurationAggregatorService.EnableRegions(context.Background(), []string{"us-south"})
+		Expect(err).To(BeNil())
+		Expect(result.Regions).To(Equal([]string{"all"}))
+		Expect(server.puts).To(BeEmpty())
+
+		server.settings["regions"] = []interface{}{"us-south"}
+		result, err = configurationAggregatorService.EnableRegions(context.Background(), []string{"eu-de", "all"})
+		Expect(err).To(BeNil())
+		Expect(result.Regions).To(Equal([]string{"all"}))
+		Expect(server.puts).To(HaveLen(1))
+	})
+	It(`Invoke DisableRegions with error`, func() {
+		server.settings["regions"] = []interface{}{"all"}
+		configurationAggregatorService := server.service()
+
+		_, err := configurationAggregatorService.DisableRegions(context.Background(), []string{"us-south"})
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("enabled for all regions"))
+		Expect(server.puts).To(BeEmpty())
+	})
+	It(`Invoke AddEnterpriseScope and RemoveEnterpriseScope successfully`, func() {
+		configurationAggregatorService := server.service()
+
+		scope := &configurationaggregatorv1.AdditionalScope{
+			EnterpriseID: core.StringPtr("9b2f5bc8a3d04f4e8e7b3f6c1c1d2e3f"),
+			ProfileTemplate: &configurationaggregatorv1.ProfileTemplate{
+				ID:               core.StringPtr("ProfileTemplate-1"),
+				TrustedProfileID: core.StringPtr("Profile-1"),
+			},
+		}
+		result, err := configurationAggregatorService.AddEnterpriseScope(context.Background(), scope)
+		Expect(err).To(BeNil())
+		Expect(result.AdditionalScope).To(HaveLen(2))
+		Expect(*result.AdditionalScope[1].Type).To(Equal(configurationaggregatorv1.AdditionalScope_Type_Enterprise))
+		Expect(scope.Type).To(BeNil())
+
+		scope.ProfileTemplate.ID = core.StringPtr("ProfileTemplate-2")
+		result, err = configurationAggregatorService.AddEnterpriseScope(context.Background(), scope)
+		Expect(err).To(BeNil())
+		Expect(result.AdditionalScope).To(HaveLen(2))
+		Expect(*result.AdditionalScope[1].ProfileTemplate.ID).To(Equal("ProfileTemplate-2"))
+
+		result, err = configurationAggregatorService.RemoveEnterpriseScope(context.Background(), "2c99aed413954f93b7cf7ce9fda6de61")
+		Expect(err).To(BeNil())
+		Expect(result.AdditionalScope).To(HaveLen(1))
+		Expect(*result.AdditionalScope[0].EnterpriseID).To(Equal("9b2f5bc8a3d04f4e8e7b3f6c1c1d2e3f"))
+		Expect(result.Regions).To(Equal([]string{"us-south", "eu-de"}))
+		Expect(server.puts).To(HaveLen(3))
+
+		_, err = configurationAggregatorService.AddEnterpriseScope(context.Background(), nil)
+		Expect(err).ToNot(BeNil())
+		_, err = configurationAggregatorService.AddEnterpriseScope(context.Background(), &configurationaggregatorv1.AdditionalScope{})
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Invoke SetTrustedProfile successfully`, func() {
+		configurationAggregatorService := server.service()
+
+		result, err := configurationAggregatorService.SetTrustedProfile(context.Background(), "Profile-2")
+		Expect(err).To(BeNil())
+		Expect(*result.TrustedProfileID).To(Equal("Profile-2"))
+		Expect(server.puts[0]["regions"]).To(Equal([]interface{}{"us-south", "eu-de"}))
+	})
+	It(`Retry an update after a concurrent modification`, func() {
+		server.beforeGet = func(n int, settings map[string]interface{}) {
+			// Another client enables a region between the first read and the check.
+			if n == 2 {
+				settings["regions"] = []interface{}{"us-south", "eu-de", "au-syd"}
+				settings["last_updated"] = "2026-01-15T00:00:00.000Z"
+			}
+		}
+		configurationAggregatorService := server.service()
+
+		var updates int
+		options := configurationAggregatorService.NewUpdateSettingsOptions(func(settings *configurationaggregatorv1.ReplaceSettingsOptions) error {
+			updates++
+			settings.Regions = append(settings.Regions, "jp-osa")
+			return nil
+		})
+		result, err := configurationAggregatorService.UpdateSettings(context.Background(), options)
+		Expect(err).To(BeNil())
+		Expect(result.Regions).To(Equal([]string{"us-south", "eu-de", "au-syd", "jp-osa"}))
+		Expect(updates).To(Equal(2))
+		Expect(server.gets).To(Equal(3))
+		Expect(server.puts).To(HaveLen(1))
+	})
+	It(`Invoke UpdateSettings with a conflict`, func() {
+		server.beforeGet = func(n int, settings map[string]interface{}) {
+			settings["last_updated"] = fmt.Sprintf("2026-01-15T00:00:%02d.000Z", n)
+		}
+		configurationAggregatorService := server.service()
+
+		options := configurationAggregatorService.NewUpdateSettingsOptions(func(settings *configurationaggregatorv1.ReplaceSettingsOptions) error {
+			settings.TrustedProfileID = core.StringPtr("Profile-2")
+			return nil
+		}).SetMaxRetries(1)
+		_, err := configurationAggregatorService.UpdateSettings(context.Background(), options)
+		Expect(err).ToNot(BeNil())
+		var conflictErr *configurationaggregatorv1.SettingsConflictError
+		Expect(errors.As(err, &conflictErr)).To(BeTrue())
+		Expect(conflictErr.Expected).To(Equal(CreateMockDateTime("2026-01-15T00:00:02.000Z")))
+		Expect(conflictErr.Actual).To(Equal(CreateMockDateTime("2026-01-15T00:00:03.000Z")))
+		Expect(server.gets).To(Equal(3))
+		Expect(server.puts).To(BeEmpty())
+	})
+	It(`Invoke UpdateSettings with error`, func() {
+		configurationAggregatorService := server.service()
+
+		_, err := configurationAggregatorService.UpdateSettings(context.Background(), nil)
+		Expect(err).ToNot(BeNil())
+		_, err = configurationAggregatorService.UpdateSettings(context.Background(), configurationAggregatorService.NewUpdateSettingsOptions(nil))
+		Expect(err).ToNot(BeNil())
+
+		options := configurationAggregatorService.NewUpdateSettingsOptions(func(settings *configurationaggregatorv1.ReplaceSettingsOptions) error {
+			return errors.New("update failed")
+		})
+		_, err = configurationAggregatorService.UpdateSettings(context.Background(), options)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("update failed"))
+		Expect(server.puts).To(BeEmpty())
+	})
+})
@@ -0,0 +1,215 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configurationaggregatorv1
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	common "github.com/IBM/configuration-aggregator-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// CollectionRegion_All is the region value that enables the resource collection across all regions.
+const CollectionRegion_All = "all"
+
+// collectionOnlyRegions is the list of regions across which the resource collection can be enabled, although the
+// service itself is not available in them, so that they have no service endpoint.
+var collectionOnlyRegions = []string{
+	"in-che",
+}
+
+// collectionRegions is the list of regions across which the resource collection can be enabled, besides "all":
+// the regions in which the service is available, and the collectionOnlyRegions.
+var collectionRegions = func() []string {
+	regions := append(append([]string(nil), serviceRegions...), collectionOnlyRegions...)
+	sort.Strings(regions)
+	return regions
+}()
+
+// IsSupportedCollectionRegion returns true if the resource collection can be enabled for the specified region.
+func IsSupportedCollectionRegion(region string) bool {
+	return region == CollectionRegion_All || containsString(collectionRegions, region)
+}
+
+// GetSupportedCollectionRegions returns the regions across which the resource collection can be enabled,
+// besides "all".
+func GetSupportedCollectionRegions() []string {
+	return append([]string(nil), collectionRegions...)
+}
+
+var (
+	trustedProfileIDPattern  = regexp.MustCompile(`^Profile-[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+	profileTemplateIDPattern = regexp.MustCompile(`^ProfileTemplate-[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+	enterpriseIDPattern      = regexp.MustCompile(`^[0-9a-fA-F]{32}$`)
+)
+
+// FieldViolation : A field whose value is not valid.
+type FieldViolation struct {
+	// The path of the field, e.g. "regions[1]" or "additional_scope[0].profile_template.id".
+	Field string `json:"field"`
+
+	// The reason the value is not valid.
+	Message string `json:"message"`
+}
+
+// String returns the field and the reason its value is not valid.
+func (violation FieldViolation) String() string {
+	return fmt.Sprintf("%s: %s", violation.Field, violation.Message)
+}
+
+// ValidationError is the cause of the error returned by the Validate() methods. It holds all the violations found.
+// Use errors.As() to retrieve it from the returned error.
+type ValidationError struct {
+	// The fields whose value is not valid.
+	Violations []FieldViolation
+}
+
+// Error returns the message of the error, listing all the violations.
+func (e *ValidationError) Error() string {
+	messages := make([]string, len(e.Violations))
+	for i, violation := range e.Violations {
+		messages[i] = violation.String()
+	}
+	return fmt.Sprintf("%d validation error(s): %s", len(e.Violations), strings.Join(messages, "; "))
+}
+
+// newValidationError returns the error reporting the violations, or nil if there are none.
+func newValidationError(violations []FieldViolation) error {
+	if len(violations) == 0 {
+		return nil
+	}
+	return core.SDKErrorf(&ValidationError{Violations: violations}, "", "validation-error", common.GetComponentInfo())
+}
+
+// Validate checks the settings on the client side before they are sent to ReplaceSettings: the regions must be
+// supported collection regions or "all", the trusted profile ID must be an IAM trusted profile ID
+// ("Profile-<uuid>"), and each additional scope must be valid. All the violations are reported at once by a
+// *ValidationError. See AdditionalScope.Validate() for the rules that apply to additional scopes.
+func (_options *ReplaceSettingsOptions) Validate() error {
+	if _options == nil {
+		return newValidationError([]FieldViolation{{Field: "$", Message: "the settings must be specified"}})
+	}
+	var violations []FieldViolation
+
+	for i, region := range _options.Regions {
+		field := fmt.Sprintf("regions[%d]", i)
+		switch {
+		case !IsSupportedCollectionRegion(region):
+			violations = append(violations, FieldViolation{
+				Field:   field,
+				Message: fmt.Sprintf("'%s' is not a supported region, supported regions are: %s, %s", region, CollectionRegion_All, strings.Join(collectionRegions, ", ")),
+			})
+		case containsString(_options.Regions[:i], region):
+			violations = append(violations, FieldViolation{Field: field, Message: fmt.Sprintf("'%s' is specified more than once", region)})
+		case region == CollectionRegion_All && len(_options.Regions) > 1:
+			violations = append(violations, FieldViolation{Field: field, Message: fmt.Sprintf("'%s' cannot be combined with other regions", CollectionRegion_All)})
+		}
+	}
+
+	if _options.TrustedProfileID != nil {
+		violations = append(violations, validateTrustedProfileID("trusted_profile_id", *_options.TrustedProfileID)...)
+	}
+
+	var enterpriseIDs []string
+	for i := range _options.AdditionalScope {
+		prefix := fmt.Sprintf("additional_scope[%d]", i)
+		scope := &_options.AdditionalScope[i]
+		violations = append(violations, scope.validate(prefix+".")...)
+		if scope.EnterpriseID != nil {
+			if containsString(enterpriseIDs, *scope.EnterpriseID) {
+				violations = append(violations, FieldViolation{
+					Field:   prefix + ".enterprise_id",
+					Message: fmt.Sprintf("the enterprise '%s' has more than one additional scope", *scope.EnterpriseID),
+				})
+			}
+			enterpriseIDs = append(enterpriseIDs, *scope.EnterpriseID)
+		}
+	}
+	return newValidationError(violations)
+}
+
+// Validate checks the additional scope on the client side: its type must be "Enterprise", the enterprise ID must be
+// a 32-character hexadecimal ID, and the profile template must be specified with a profile template ID
+// ("ProfileTemplate-<uuid>") and an IAM trusted profile ID ("Profile-<uuid>"). All the violations are reported at once
+// by a *ValidationError.
+func (o *AdditionalScope) Validate() error {
+	if o == nil {
+		return newValidationError([]FieldViolation{{Field: "$", Message: "the additional scope must be specified"}})
+	}
+	return newValidationError(o.validate(""))
+}
+
+func (o *AdditionalScope) validate(prefix string) (violations []FieldViolation) {
+	switch {
+	case o.Type == nil:
+		violations = append(violations, FieldViolation{Field: prefix + "type", Message: "the type must be specified"})
+	case *o.Type != AdditionalScope_Type_Enterprise:
+		violations = append(violations, FieldViolation{
+			Field:   prefix + "type",
+			Message: fmt.Sprintf("'%s' is not a supported type, the only supported type is %s", *o.Type, AdditionalScope_Type_Enterprise),
+		})
+	}
+
+	switch {
+	case o.EnterpriseID == nil:
+		violations = append(violations, FieldViolation{Field: prefix + "enterprise_id", Message: "the enterprise ID must be specified"})
+	case !enterpriseIDPattern.MatchString(*o.EnterpriseID):
+		violations = append(violations, FieldViolation{
+			Field:   prefix + "enterprise_id",
+			Message: fmt.Sprintf("'%s' is not a valid enterprise ID, expected 32 hexadecimal characters", *o.EnterpriseID),
+		})
+	}
+
+	if o.ProfileTemplate == nil {
+		violations = append(violations, FieldViolation{
+			Field:   prefix + "profile_template",
+			Message: "the profile template must be specified for an enterprise scope",
+		})
+		return
+	}
+	switch {
+	case o.ProfileTemplate.ID == nil:
+		violations = append(violations, FieldViolation{Field: prefix + "profile_template.id", Message: "the profile template ID must be specified"})
+	case !profileTemplateIDPattern.MatchString(*o.ProfileTemplate.ID):
+		violations = append(violations, FieldViolation{
+			Field:   prefix + "profile_template.id",
+			Message: fmt.Sprintf("'%s' is not a valid profile template ID, expected ProfileTemplate-<uuid>", *o.ProfileTemplate.ID),
+		})
+	}
+	if o.ProfileTemplate.TrustedProfileID == nil {
+		violations = append(violations, FieldViolation{
+			Field:   prefix + "profile_template.trusted_profile_id",
+			Message: "the trusted profile ID of the profile template must be specified",
+		})
+	} else {
+		violations = append(violations, validateTrustedProfileID(prefix+"profile_template.trusted_profile_id", *o.ProfileTemplate.TrustedProfileID)...)
+	}
+	return
+}
+
+func validateTrustedProfileID(field string, trustedProfileID string) []FieldViolation {
+	if trustedProfileIDPattern.MatchString(trustedProfileID) {
+		return nil
+	}
+	return []FieldViolation{{
+		Field:   field,
+		Message: fmt.Sprintf("'%s' is not a valid trusted profile ID, expected Profile-<uuid>", trustedProfileID),
+	}}
+}
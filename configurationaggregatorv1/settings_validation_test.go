@@ -0,0 +1,121 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configurationaggregatorv1_test
+
+import (
+	"errors"
+
+	"github.com/IBM/configuration-aggregator-go-sdk/configurationaggregatorv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ConfigurationAggregatorV1 settings validation`, func() {
+	var additionalScopeModel *configurationaggregatorv1.AdditionalScope
+
+	BeforeEach(func() {
+		additionalScopeModel = &configurationaggregatorv1.AdditionalScope{
+			Type:         core.StringPtr("Enterprise"),
+			EnterpriseID: core.StringPtr("2c99aed413954f93b7cf7ce9fda6de61"),
+			ProfileTemplate: &configurationaggregatorv1.ProfileTemplate{
+				ID:               core.StringPtr("ProfileTemplate-adb55769-ae22-4c60-aead-bd1f84f93c57"),
+				TrustedProfileID: core.StringPtr("Profile-39acf232-8969-4c32-9838-83eb60a037f7"),
+			},
+		}
+	})
+
+	violationsOf := func(err error) []configurationaggregatorv1.FieldViolation {
+		Expect(err).ToNot(BeNil())
+		var validationErr *configurationaggregatorv1.ValidationError
+		Expect(errors.As(err, &validationErr)).To(BeTrue())
+		return validationErr.Violations
+	}
+	fieldsOf := func(violations []configurationaggregatorv1.FieldViolation) (fields []string) {
+		for _, violation := range violations {
+			fields = append(fields, violation.Field)
+		}
+		return
+	}
+
+	It(`Invoke ReplaceSettingsOptions.Validate successfully`, func() {
+		replaceSettingsOptions := new(configurationaggregatorv1.ReplaceSettingsOptions).
+			SetResourceCollectionEnabled(true).
+			SetTrustedProfileID("Profile-39acf232-8969-4c32-9838-83eb60a037f7").
+			SetRegions([]string{"us-south", "eu-de"}).
+			SetAdditionalScope([]configurationaggregatorv1.AdditionalScope{*additionalScopeModel})
+		Expect(replaceSettingsOptions.Validate()).To(Succeed())
+
+		Expect(new(configurationaggregatorv1.ReplaceSettingsOptions).SetRegions([]string{"all"}).Validate()).To(Succeed())
+		Expect(new(configurationaggregatorv1.ReplaceSettingsOptions).Validate()).To(Succeed())
+	})
+	It(`Invoke ReplaceSettingsOptions.Validate with all violations`, func() {
+		otherScope := *additionalScopeModel
+		otherScope.Type = core.StringPtr("Account")
+		otherScope.ProfileTemplate = nil
+
+		replaceSettingsOptions := new(configurationaggregatorv1.ReplaceSettingsOptions).
+			SetTrustedProfileID("39acf232-8969-4c32-9838-83eb60a037f7").
+			SetRegions([]string{"us-south", "us-sout", "us-south", "all"}).
+			SetAdditionalScope([]configurationaggregatorv1.AdditionalScope{*additionalScopeModel, otherScope})
+		err := replaceSettingsOptions.Validate()
+		violations := violationsOf(err)
+		Expect(fieldsOf(violations)).To(Equal([]string{
+			"regions[1]",
+			"regions[2]",
+			"regions[3]",
+			"trusted_profile_id",
+			"additional_scope[1].type",
+			"additional_scope[1].profile_template",
+			"additional_scope[1].enterprise_id",
+		}))
+		Expect(violations[0].Message).To(ContainSubstring("'us-sout' is not a supported region"))
+		Expect(err.Error()).To(ContainSubstring("7 validation error(s)"))
+		Expect(err.Error()).To(ContainSubstring("trusted_profile_id: '39acf232-8969-4c32-9838-83eb60a037f7' is not a valid trusted profile ID"))
+
+		var nilOptions *configurationaggregatorv1.ReplaceSettingsOptions
+		Expect(nilOptions.Validate()).ToNot(Succeed())
+	})
+	It(`Invoke AdditionalScope.Validate with all violations`, func() {
+		Expect(additionalScopeModel.Validate()).To(Succeed())
+
+		Expect(fieldsOf(violationsOf(new(configurationaggregatorv1.AdditionalScope).Validate()))).To(Equal([]string{
+			"type", "enterprise_id", "profile_template",
+		}))
+
+		additionalScopeModel.EnterpriseID = core.StringPtr("enterprise-1")
+		additionalScopeModel.ProfileTemplate.ID = core.StringPtr("Profile-adb55769-ae22-4c60-aead-bd1f84f93c57")
+		additionalScopeModel.ProfileTemplate.TrustedProfileID = nil
+		Expect(fieldsOf(violationsOf(additionalScopeModel.Validate()))).To(Equal([]string{
+			"enterprise_id", "profile_template.id", "profile_template.trusted_profile_id",
+		}))
+
+		var nilScope *configurationaggregatorv1.AdditionalScope
+		Expect(nilScope.Validate()).ToNot(Succeed())
+	})
+	It(`Check the supported collection regions`, func() {
+		Expect(configurationaggregatorv1.IsSupportedCollectionRegion("all")).To(BeTrue())
+		Expect(configurationaggregatorv1.IsSupportedCollectionRegion("in-che")).To(BeTrue())
+		Expect(configurationaggregatorv1.IsSupportedCollectionRegion("mars-1")).To(BeFalse())
+		Expect(configurationaggregatorv1.GetSupportedCollectionRegions()).To(ContainElement("us-south"))
+		Expect(configurationaggregatorv1.GetSupportedCollectionRegions()).ToNot(ContainElement("all"))
+		for _, region := range configurationaggregatorv1.GetSupportedRegions() {
+			Expect(configurationaggregatorv1.IsSupportedCollectionRegion(region)).To(BeTrue())
+		}
+		Expect(configurationaggregatorv1.IsSupportedRegion("in-che")).To(BeFalse())
+	})
+})
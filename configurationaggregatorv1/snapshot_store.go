@@ -0,0 +1,440 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configurationaggregatorv1
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	common "github.com/IBM/configuration-aggregator-go-sdk/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/go-openapi/strfmt"
+)
+
+// SnapshotFormatVersion is the version of the on-disk format written by SnapshotStore.
+const SnapshotFormatVersion = 1
+
+const (
+	snapshotFilePrefix = "snapshot-"
+	snapshotFileSuffix = ".json.gz"
+
+	// snapshotIDLayout is the layout of snapshot IDs. IDs sort in the order of their capture time.
+	snapshotIDLayout = "20060102T150405.000000000Z"
+)
+
+// Snapshot : The resource configurations collected at a given time, along with the settings
+// and the status of the resource collection at that time.
+type Snapshot struct {
+	// The unique identifier of the snapshot, derived from its capture time.
+	ID string `json:"id"`
+
+	// The time at which the snapshot was captured.
+	CapturedAt *strfmt.DateTime `json:"captured_at"`
+
+	// The settings of the resource collection at capture time.
+	Settings *SettingsResponse `json:"settings,omitempty"`
+
+	// The status of the resource collection at capture time.
+	Status *StatusResponse `json:"status,omitempty"`
+
+	// The resource configurations.
+	Configs []Config `json:"configs"`
+}
+
+// NewSnapshot returns a new Snapshot of the specified resource configurations captured at the specified time.
+func NewSnapshot(capturedAt time.Time, configs []Config) *Snapshot {
+	capturedAt = capturedAt.UTC()
+	dateTime := strfmt.DateTime(capturedAt)
+	return &Snapshot{
+		ID:         capturedAt.Format(snapshotIDLayout),
+		CapturedAt: &dateTime,
+		Configs:    configs,
+	}
+}
+
+// ListConfigs returns a page of the resource configurations of the snapshot that satisfy the filters
+// of the options, in the format of the ListConfigs operation. See ListConfigsPage() for details.
+func (snapshot *Snapshot) ListConfigs(listConfigsOptions *ListConfigsOptions) (result *ListConfigsResponse, err error) {
+	result, err = ListConfigsPage(snapshot.Configs, listConfigsOptions)
+	err = core.RepurposeSDKProblem(err, "snapshot-list-error")
+	return
+}
+
+// FilterConfigs returns all the resource configurations of the snapshot that satisfy the filters of the options.
+// Limit and Start are ignored.
+func (snapshot *Snapshot) FilterConfigs(listConfigsOptions *ListConfigsOptions) []Config {
+	return FilterConfigs(snapshot.Configs, listConfigsOptions)
+}
+
+// CaptureSnapshot retrieves the settings, the status of the resource collection and all the resource configurations
+// that satisfy the specified options, and returns them as a Snapshot. The options may be nil.
+func (configurationAggregator *ConfigurationAggregatorV1) CaptureSnapshot(ctx context.Context, listConfigsOptions *ListConfigsOptions) (snapshot *Snapshot, err error) {
+	capturedAt := time.Now()
+
+	settings, _, err := configurationAggregator.GetSettingsWithContext(ctx, configurationAggregator.NewGetSettingsOptions())
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "snapshot-settings-error")
+		return
+	}
+	status, _, err := configurationAggregator.GetResourceCollectionStatusWithContext(ctx, configurationAggregator.NewGetResourceCollectionStatusOptions())
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "snapshot-status-error")
+		return
+	}
+
+	if listConfigsOptions == nil {
+		listConfigsOptions = configurationAggregator.NewListConfigsOptions()
+	}
+	pager, err := configurationAggregator.NewConfigsPager(listConfigsOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "snapshot-pager-error")
+		return
+	}
+	configs, err := pager.GetAllWithContext(ctx)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "snapshot-configs-error")
+		return
+	}
+
+	snapshot = NewSnapshot(capturedAt, configs)
+	snapshot.Settings = settings
+	snapshot.Status = status
+	return
+}
+
+// SnapshotInfo : The description of a snapshot persisted in a SnapshotStore.
+type SnapshotInfo struct {
+	// The unique identifier of the snapshot.
+	ID string
+
+	// The time at which the snapshot was captured.
+	CapturedAt time.Time
+
+	// The path of the snapshot file.
+	Path string
+
+	// The size of the snapshot file, in bytes.
+	Size int64
+}
+
+// SnapshotStore persists snapshots as gzip-compressed JSON files in a local directory.
+// Each snapshot is stored in its own file, named after its ID.
+type SnapshotStore struct {
+	dir string
+}
+
+// NewSnapshotStore returns a SnapshotStore that persists snapshots in the specified directory,
+// which is created if it does not exist.
+func NewSnapshotStore(dir string) (store *SnapshotStore, err error) {
+	err = os.MkdirAll(dir, 0o750)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "snapshot-dir-error", common.GetComponentInfo())
+		return
+	}
+	store = &SnapshotStore{dir: dir}
+	return
+}
+
+// Dir returns the directory in which the snapshots are persisted.
+func (store *SnapshotStore) Dir() string {
+	return store.dir
+}
+
+func (store *SnapshotStore) path(id string) string {
+	return filepath.Join(store.dir, snapshotFilePrefix+id+snapshotFileSuffix)
+}
+
+// Save persists the snapshot, replacing any snapshot with the same ID. The snapshot is first written
+// to a temporary file which is then renamed, so that a partially written snapshot is never visible.
+func (store *SnapshotStore) Save(snapshot *Snapshot) (info *SnapshotInfo, err error) {
+	err = core.ValidateNotNil(snapshot, "snapshot cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	if snapshot.CapturedAt == nil {
+		err = core.SDKErrorf(nil, "the capture time of the snapshot must be set", "snapshot-no-time", common.GetComponentInfo())
+		return
+	}
+	if snapshot.ID == "" {
+		snapshot.ID = time.Time(*snapshot.CapturedAt).UTC().Format(snapshotIDLayout)
+	}
+	if _, parseErr := time.Parse(snapshotIDLayout, snapshot.ID); parseErr != nil {
+		err = core.SDKErrorf(parseErr, fmt.Sprintf("invalid snapshot ID: '%s'", snapshot.ID), "snapshot-invalid-id", common.GetComponentInfo())
+		return
+	}
+
+	file, err := os.CreateTemp(store.dir, ".snapshot-*.tmp")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "snapshot-create-error", common.GetComponentInfo())
+		return
+	}
+	defer func() {
+		if err != nil {
+			_ = file.Close()
+			_ = os.Remove(file.Name())
+		}
+	}()
+
+	buffered := bufio.NewWriter(file)
+	compressed := gzip.NewWriter(buffered)
+	err = writeSnapshot(compressed, snapshot)
+	if err == nil {
+		err = compressed.Close()
+	}
+	if err == nil {
+		err = buffered.Flush()
+	}
+	if err == nil {
+		err = file.Close()
+	}
+	if err != nil {
+		err = core.SDKErrorf(err, "", "snapshot-write-error", common.GetComponentInfo())
+		return
+	}
+
+	path := store.path(snapshot.ID)
+	err = os.Rename(file.Name(), path)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "snapshot-rename-error", common.GetComponentInfo())
+		return
+	}
+	return store.stat(snapshot.ID)
+}
+
+// writeSnapshot encodes the snapshot one resource configuration at a time, so that large snapshots
+// are not held twice in memory.
+func writeSnapshot(writer io.Writer, snapshot *Snapshot) error {
+	header := map[string]interface{}{
+		"format_version": SnapshotFormatVersion,
+		"id":             snapshot.ID,
+		"captured_at":    snapshot.CapturedAt,
+	}
+	if snapshot.Settings != nil {
+		header["settings"] = snapshot.Settings
+	}
+	if snapshot.Status != nil {
+		header["status"] = snapshot.Status
+	}
+	buffer, err := json.Marshal(header)
+	if err != nil {
+		return err
+	}
+	// Re-open the header object to append the configs.
+	if _, err = writer.Write(buffer[:len(buffer)-1]); err != nil {
+		return err
+	}
+	if _, err = io.WriteString(writer, `,"configs":[`); err != nil {
+		return err
+	}
+	for i := range snapshot.Configs {
+		if i > 0 {
+			if _, err = io.WriteString(writer, ",\n"); err != nil {
+				return err
+			}
+		}
+		buffer, err = json.Marshal(&snapshot.Configs[i])
+		if err != nil {
+			return err
+		}
+		if _, err = writer.Write(buffer); err != nil {
+			return err
+		}
+	}
+	_, err = io.WriteString(writer, "]}\n")
+	return err
+}
+
+// Load returns the snapshot with the specified ID.
+func (store *SnapshotStore) Load(id string) (snapshot *Snapshot, err error) {
+	if _, err = time.Parse(snapshotIDLayout, id); err != nil {
+		err = core.SDKErrorf(err, fmt.Sprintf("invalid snapshot ID: '%s'", id), "snapshot-invalid-id", common.GetComponentInfo())
+		return
+	}
+	file, err := os.Open(store.path(id))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			err = core.SDKErrorf(err, fmt.Sprintf("snapshot '%s' not found", id), "snapshot-not-found", common.GetComponentInfo())
+		} else {
+			err = core.SDKErrorf(err, "", "snapshot-open-error", common.GetComponentInfo())
+		}
+		return
+	}
+	defer file.Close()
+
+	snapshot, err = ReadSnapshot(file)
+	err = core.RepurposeSDKProblem(err, "snapshot-load-error")
+	return
+}
+
+// ReadSnapshot decodes a snapshot in the gzip-compressed format written by SnapshotStore.
+func ReadSnapshot(reader io.Reader) (snapshot *Snapshot, err error) {
+	decompressed, err := gzip.NewReader(reader)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "snapshot-decompress-error", common.GetComponentInfo())
+		return
+	}
+	defer decompressed.Close()
+
+	var rawSnapshot map[string]json.RawMessage
+	err = json.NewDecoder(decompressed).Decode(&rawSnapshot)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "snapshot-decode-error", common.GetComponentInfo())
+		return
+	}
+
+	var formatVersion int64
+	err = core.UnmarshalPrimitive(rawSnapshot, "format_version", &formatVersion)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "format_version-error", common.GetComponentInfo())
+		return
+	}
+	if formatVersion != SnapshotFormatVersion {
+		err = core.SDKErrorf(nil, fmt.Sprintf("unsupported snapshot format version: %d", formatVersion), "snapshot-version-error", common.GetComponentInfo())
+		return
+	}
+
+	obj := new(Snapshot)
+	err = core.UnmarshalPrimitive(rawSnapshot, "id", &obj.ID)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "id-error", common.GetComponentInfo())
+		return
+	}
+	err = core.UnmarshalPrimitive(rawSnapshot, "captured_at", &obj.CapturedAt)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "captured_at-error", common.GetComponentInfo())
+		return
+	}
+	err = core.UnmarshalModel(rawSnapshot, "settings", &obj.Settings, UnmarshalSettingsResponse)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "settings-error", common.GetComponentInfo())
+		return
+	}
+	err = core.UnmarshalModel(rawSnapshot, "status", &obj.Status, UnmarshalStatusResponse)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "status-error", common.GetComponentInfo())
+		return
+	}
+	err = core.UnmarshalModel(rawSnapshot, "configs", &obj.Configs, UnmarshalConfig)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "configs-error", common.GetComponentInfo())
+		return
+	}
+	snapshot = obj
+	return
+}
+
+// List returns the description of all the snapshots of the store, ordered by capture time.
+func (store *SnapshotStore) List() (infos []SnapshotInfo, err error) {
+	entries, err := os.ReadDir(store.dir)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "snapshot-list-error", common.GetComponentInfo())
+		return
+	}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, snapshotFilePrefix) || !strings.HasSuffix(name, snapshotFileSuffix) {
+			continue
+		}
+		id := strings.TrimSuffix(strings.TrimPrefix(name, snapshotFilePrefix), snapshotFileSuffix)
+		var info *SnapshotInfo
+		info, err = store.stat(id)
+		if err != nil {
+			// Ignore files that do not follow the naming scheme of snapshots.
+			err = nil
+			continue
+		}
+		infos = append(infos, *info)
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].CapturedAt.Before(infos[j].CapturedAt)
+	})
+	return
+}
+
+func (store *SnapshotStore) stat(id string) (info *SnapshotInfo, err error) {
+	capturedAt, err := time.Parse(snapshotIDLayout, id)
+	if err != nil {
+		err = core.SDKErrorf(err, fmt.Sprintf("invalid snapshot ID: '%s'", id), "snapshot-invalid-id", common.GetComponentInfo())
+		return
+	}
+	path := store.path(id)
+	fileInfo, err := os.Stat(path)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "snapshot-stat-error", common.GetComponentInfo())
+		return
+	}
+	info = &SnapshotInfo{
+		ID:         id,
+		CapturedAt: capturedAt,
+		Path:       path,
+		Size:       fileInfo.Size(),
+	}
+	return
+}
+
+// LoadAt returns the latest snapshot captured at or before the specified time.
+func (store *SnapshotStore) LoadAt(t time.Time) (snapshot *Snapshot, err error) {
+	infos, err := store.List()
+	if err != nil {
+		return
+	}
+	for i := len(infos) - 1; i >= 0; i-- {
+		if !infos[i].CapturedAt.After(t) {
+			return store.Load(infos[i].ID)
+		}
+	}
+	err = core.SDKErrorf(nil, fmt.Sprintf("no snapshot captured at or before %s", t.UTC().Format(time.RFC3339)), "snapshot-not-found", common.GetComponentInfo())
+	return
+}
+
+// Latest returns the most recently captured snapshot.
+func (store *SnapshotStore) Latest() (snapshot *Snapshot, err error) {
+	infos, err := store.List()
+	if err != nil {
+		return
+	}
+	if len(infos) == 0 {
+		err = core.SDKErrorf(nil, "the snapshot store is empty", "snapshot-not-found", common.GetComponentInfo())
+		return
+	}
+	return store.Load(infos[len(infos)-1].ID)
+}
+
+// Delete removes the snapshot with the specified ID.
+func (store *SnapshotStore) Delete(id string) (err error) {
+	if _, err = time.Parse(snapshotIDLayout, id); err != nil {
+		err = core.SDKErrorf(err, fmt.Sprintf("invalid snapshot ID: '%s'", id), "snapshot-invalid-id", common.GetComponentInfo())
+		return
+	}
+	err = os.Remove(store.path(id))
+	if err != nil {
+		err = core.SDKErrorf(err, "", "snapshot-delete-error", common.GetComponentInfo())
+	}
+	return
+}
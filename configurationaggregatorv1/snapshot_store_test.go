@@ -0,0 +1,198 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package configurationaggregatorv1_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/IBM/configuration-aggregator-go-sdk/configurationaggregatorv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`ConfigurationAggregatorV1 snapshot store`, func() {
+	var dir string
+	var store *configurationaggregatorv1.SnapshotStore
+
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "snapshots")
+		Expect(err).To(BeNil())
+		store, err = configurationaggregatorv1.NewSnapshotStore(filepath.Join(dir, "store"))
+		Expect(err).To(BeNil())
+		Expect(store.Dir()).To(Equal(filepath.Join(dir, "store")))
+	})
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	It(`Save and load a snapshot successfully`, func() {
+		configuration := new(configurationaggregatorv1.Configuration)
+		configuration.SetProperty("encryption", map[string]interface{}{"enabled": true})
+		config := mockConfig("crn1", "kms", "us-south", []string{"env:prod"})
+		config.Config = configuration
+		config.ConfigV2 = configuration
+
+		snapshot := configurationaggregatorv1.NewSnapshot(time.Date(2026, 1, 2, 3, 4, 5, 6, time.UTC), []configurationaggregatorv1.Config{config})
+		snapshot.Settings = &configurationaggregatorv1.SettingsResponse{
+			ResourceCollectionEnabled: core.BoolPtr(true),
+			Regions:                   []string{"all"},
+		}
+		snapshot.Status = &configurationaggregatorv1.StatusResponse{
+			Status: core.StringPtr(configurationaggregatorv1.StatusResponse_Status_Complete),
+		}
+
+		info, err := store.Save(snapshot)
+		Expect(err).To(BeNil())
+		Expect(info.ID).To(Equal("20260102T030405.000000006Z"))
+		Expect(info.CapturedAt).To(Equal(time.Date(2026, 1, 2, 3, 4, 5, 6, time.UTC)))
+		Expect(info.Size).To(BeNumerically(">", 0))
+
+		loaded, err := store.Load(info.ID)
+		Expect(err).To(BeNil())
+		Expect(loaded.ID).To(Equal(snapshot.ID))
+		Expect(loaded.Settings).To(Equal(snapshot.Settings))
+		Expect(loaded.Status).To(Equal(snapshot.Status))
+		Expect(loaded.Configs).To(HaveLen(1))
+		Expect(loaded.Configs[0].About).To(Equal(config.About))
+		enabled, err := loaded.Configs[0].Config.GetBool("encryption.enabled")
+		Expect(err).To(BeNil())
+		Expect(enabled).To(BeTrue())
+		Expect(loaded.Configs[0].ConfigV2).ToNot(BeNil())
+	})
+	It(`List and select snapshots by time`, func() {
+		base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		for i := 2; i >= 0; i-- {
+			_, err := store.Save(configurationaggregatorv1.NewSnapshot(base.Add(time.Duration(i)*time.Hour), []configurationaggregatorv1.Config{
+				mockConfig(fmt.Sprintf("crn%d", i), "kms", "us-south", nil),
+			}))
+			Expect(err).To(BeNil())
+		}
+		Expect(os.WriteFile(filepath.Join(store.Dir(), "snapshot-garbage.json.gz"), []byte("x"), 0o600)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(store.Dir(), "notes.txt"), []byte("x"), 0o600)).To(Succeed())
+
+		infos, err := store.List()
+		Expect(err).To(BeNil())
+		Expect(infos).To(HaveLen(3))
+		Expect(infos[0].CapturedAt).To(Equal(base))
+		Expect(infos[2].CapturedAt).To(Equal(base.Add(2 * time.Hour)))
+
+		latest, err := store.Latest()
+		Expect(err).To(BeNil())
+		Expect(*latest.Configs[0].About.ResourceCrn).To(Equal("crn2"))
+
+		snapshot, err := store.LoadAt(base.Add(90 * time.Minute))
+		Expect(err).To(BeNil())
+		Expect(*snapshot.Configs[0].About.ResourceCrn).To(Equal("crn1"))
+
+		_, err = store.LoadAt(base.Add(-time.Minute))
+		Expect(err).ToNot(BeNil())
+
+		Expect(store.Delete(infos[2].ID)).To(Succeed())
+		latest, err = store.Latest()
+		Expect(err).To(BeNil())
+		Expect(*latest.Configs[0].About.ResourceCrn).To(Equal("crn1"))
+	})
+	It(`Filter the configurations of a snapshot`, func() {
+		snapshot := configurationaggregatorv1.NewSnapshot(time.Now(), []configurationaggregatorv1.Config{
+			mockConfig("crn1", "kms", "us-south", nil),
+			mockConfig("crn2", "kms", "eu-de", nil),
+			mockConfig("crn3", "cloud-object-storage", "us-south", nil),
+		})
+		options := &configurationaggregatorv1.ListConfigsOptions{ServiceName: core.StringPtr("kms"), Limit: core.Int64Ptr(1)}
+
+		Expect(snapshot.FilterConfigs(options)).To(HaveLen(2))
+
+		page, err := snapshot.ListConfigs(options)
+		Expect(err).To(BeNil())
+		Expect(page.Configs).To(HaveLen(1))
+		Expect(*page.TotalCount).To(Equal(int64(2)))
+		Expect(page.Next).ToNot(BeNil())
+	})
+	It(`Return errors for missing or invalid snapshots`, func() {
+		_, err := store.Load("20260102T030405.000000006Z")
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("not found"))
+
+		_, err = store.Load("../../etc/passwd")
+		Expect(err).ToNot(BeNil())
+
+		_, err = store.Latest()
+		Expect(err).ToNot(BeNil())
+
+		_, err = store.Save(nil)
+		Expect(err).ToNot(BeNil())
+
+		_, err = store.Save(&configurationaggregatorv1.Snapshot{})
+		Expect(err).ToNot(BeNil())
+
+		Expect(os.WriteFile(filepath.Join(store.Dir(), "snapshot-20260102T030405.000000006Z.json.gz"), []byte("not gzip"), 0o600)).To(Succeed())
+		_, err = store.Load("20260102T030405.000000006Z")
+		Expect(err).ToNot(BeNil())
+
+		Expect(store.Delete("invalid")).ToNot(Succeed())
+	})
+	It(`Capture a snapshot successfully`, func() {
+		testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			switch req.URL.EscapedPath() {
+			case "/settings":
+				fmt.Fprint(res, `{"resource_collection_enabled":true,"trusted_profile_id":"Profile-1","regions":["all"]}`)
+			case "/resource_collection_status":
+				fmt.Fprint(res, `{"last_config_refresh_time":"2026-01-01T12:00:00.000Z","status":"complete"}`)
+			case "/configs":
+				Expect(req.URL.Query().Get("service_name")).To(Equal("kms"))
+				if req.URL.Query().Get("start") == "" {
+					fmt.Fprintf(res, `{"next":{"start":"1"},"configs":[%s]}`, mockConfigJSON("crn1"))
+				} else {
+					fmt.Fprintf(res, `{"configs":[%s]}`, mockConfigJSON("crn2"))
+				}
+			}
+		}))
+		defer testServer.Close()
+
+		configurationAggregatorService, serviceErr := configurationaggregatorv1.NewConfigurationAggregatorV1(&configurationaggregatorv1.ConfigurationAggregatorV1Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+
+		snapshot, err := configurationAggregatorService.CaptureSnapshot(context.Background(), configurationAggregatorService.NewListConfigsOptions().SetServiceName("kms"))
+		Expect(err).To(BeNil())
+		Expect(snapshot.Configs).To(HaveLen(2))
+		Expect(snapshot.Settings.TrustedProfileID).To(Equal(core.StringPtr("Profile-1")))
+		Expect(snapshot.Status.Status).To(Equal(core.StringPtr("complete")))
+		Expect(snapshot.ID).ToNot(BeEmpty())
+
+		_, err = store.Save(snapshot)
+		Expect(err).To(BeNil())
+
+		testServer.Close()
+		_, err = configurationAggregatorService.CaptureSnapshot(context.Background(), nil)
+		Expect(err).ToNot(BeNil())
+	})
+})
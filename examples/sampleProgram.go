@@ -32,7 +32,6 @@ import (
 var configAggregatorInstance *configurationaggregatorv1.ConfigurationAggregatorV1
 
 func initAndReturnSingletonInstanceWithAPIKey(authToken string, guid string, region string) *configurationaggregatorv1.ConfigurationAggregatorV1 {
-	instanceURL := "https://" + region + ".apprapp.cloud.ibm.com/apprapp/config_aggregator/v1/instances/" + guid
 	var once sync.Once
 	if configAggregatorInstance == nil {
 		once.Do(func() {
@@ -43,7 +42,8 @@ func initAndReturnSingletonInstanceWithAPIKey(authToken string, guid string, reg
 				}
 				options := &configurationaggregatorv1.ConfigurationAggregatorV1Options{
 					Authenticator: authenticator,
-					URL:           instanceURL,
+					Region:        region,
+					InstanceID:    guid,
 				}
 				var error error
 				configAggregatorInstance, error = configurationaggregatorv1.NewConfigurationAggregatorV1(options)
@@ -59,7 +59,6 @@ func initAndReturnSingletonInstanceWithAPIKey(authToken string, guid string, reg
 }
 
 func initAndReturnSingletonInstanceWithBearertoken(authToken string, guid string, region string) *configurationaggregatorv1.ConfigurationAggregatorV1 {
-	instanceURL := "https://" + region + ".apprapp.cloud.ibm.com/apprapp/config_aggregator/v1/instances/" + guid
 	var once sync.Once
 	if configAggregatorInstance == nil {
 		once.Do(func() {
@@ -69,7 +68,8 @@ func initAndReturnSingletonInstanceWithBearertoken(authToken string, guid string
 				}
 				options := &configurationaggregatorv1.ConfigurationAggregatorV1Options{
 					Authenticator: authenticator,
-					URL:           instanceURL,
+					Region:        region,
+					InstanceID:    guid,
 				}
 				var error error
 				configAggregatorInstance, error = configurationaggregatorv1.NewConfigurationAggregatorV1(options)